@@ -0,0 +1,84 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package cmd contains the CLI commands for Zarf.
+package cmd
+
+import (
+	"strings"
+
+	"github.com/defenseunicorns/zarf/src/cmd/common"
+	"github.com/defenseunicorns/zarf/src/config"
+	"github.com/defenseunicorns/zarf/src/config/lang"
+	"github.com/defenseunicorns/zarf/src/pkg/bundle"
+	"github.com/defenseunicorns/zarf/src/pkg/message"
+	"github.com/defenseunicorns/zarf/src/pkg/utils/helpers"
+	"github.com/defenseunicorns/zarf/src/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var bundleDeployOpts types.ZarfDeployOptions
+var bundleSetVariables map[string]string
+
+var bundleCmd = &cobra.Command{
+	Use:     "bundle",
+	Aliases: []string{"b"},
+	Short:   lang.CmdBundleShort,
+}
+
+var bundleDeployCmd = &cobra.Command{
+	Use:     "deploy BUNDLE_FILE",
+	Aliases: []string{"d"},
+	Short:   lang.CmdBundleDeployShort,
+	Long:    lang.CmdBundleDeployLong,
+	Example: lang.CmdBundleDeployExample,
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		// Ensure uppercase keys from viper and CLI --set
+		v := common.GetViper()
+
+		// Merge the viper config file variables and provided CLI flag variables (CLI takes precedence))
+		setVariables := helpers.TransformAndMergeMap(
+			v.GetStringMapString(common.VBundleDeploySet), bundleSetVariables, strings.ToUpper)
+
+		zarfBundle, err := bundle.Read(args[0])
+		if err != nil {
+			message.Fatalf(err, lang.CmdBundleDeployErr, err.Error())
+		}
+
+		// Deploy the bundle
+		if err := bundle.Deploy(zarfBundle, bundleDeployOpts, setVariables); err != nil {
+			message.Fatalf(err, lang.CmdBundleDeployErr, err.Error())
+		}
+	},
+}
+
+func init() {
+	v := common.InitViper()
+
+	rootCmd.AddCommand(bundleCmd)
+	bundleCmd.AddCommand(bundleDeployCmd)
+
+	bindBundleDeployFlags(v)
+}
+
+func bindBundleDeployFlags(v *viper.Viper) {
+	deployFlags := bundleDeployCmd.Flags()
+
+	// Always require confirm flag (no viper)
+	deployFlags.BoolVar(&config.CommonOptions.Confirm, "confirm", false, lang.CmdPackageDeployFlagConfirm)
+
+	// Always require adopt-existing-resources flag (no viper)
+	deployFlags.BoolVar(&bundleDeployOpts.AdoptExistingResources, "adopt-existing-resources", false, lang.CmdPackageDeployFlagAdoptExistingResources)
+
+	deployFlags.BoolVar(&bundleDeployOpts.SkipWebhooks, "skip-webhooks", v.GetBool(common.VBundleDeploySkipWebhooks), lang.CmdPackageDeployFlagSkipWebhooks)
+
+	deployFlags.DurationVar(&bundleDeployOpts.Timeout, "timeout", v.GetDuration(common.VBundleDeployTimeout), lang.CmdPackageDeployFlagTimeout)
+	deployFlags.BoolVar(&bundleDeployOpts.Atomic, "atomic", v.GetBool(common.VBundleDeployAtomic), lang.CmdPackageDeployFlagAtomic)
+	deployFlags.IntVar(&bundleDeployOpts.Concurrency, "concurrency", v.GetInt(common.VBundleDeployConcurrency), lang.CmdPackageDeployFlagConcurrency)
+	deployFlags.IntVar(&bundleDeployOpts.ImagePushConcurrency, "image-push-concurrency", v.GetInt(common.VBundleDeployImagePush), lang.CmdPackageDeployFlagImagePushConcurrency)
+	deployFlags.BoolVar(&bundleDeployOpts.Resume, "resume", v.GetBool(common.VBundleDeployResume), lang.CmdPackageDeployFlagResume)
+
+	deployFlags.StringToStringVar(&bundleSetVariables, "set", v.GetStringMapString(common.VBundleDeploySet), lang.CmdBundleDeployFlagSet)
+}
@@ -16,6 +16,12 @@ import (
 // LogLevelCLI holds the log level as input from a command
 var LogLevelCLI string
 
+// LogFormatCLI holds the log format as input from a command
+var LogFormatCLI string
+
+// LogOutputCLI holds the file to write JSON events to as input from a command
+var LogOutputCLI string
+
 // SetupCLI sets up the CLI logging, interrupt functions, and more
 func SetupCLI() {
 	exec.ExitOnInterrupt()
@@ -43,6 +49,14 @@ func SetupCLI() {
 		}
 	}
 
+	switch LogFormatCLI {
+	case "", "text":
+	case "json":
+		setupEvents()
+	default:
+		message.Warn(lang.RootCmdErrInvalidLogFormat)
+	}
+
 	// Disable progress bars for CI envs
 	if os.Getenv("CI") == "true" {
 		message.Debug("CI environment detected, disabling progress bars")
@@ -53,3 +67,23 @@ func SetupCLI() {
 		message.UseLogFile()
 	}
 }
+
+// setupEvents writes structured events as JSON lines to stderr or the --log-output file.
+// Events are kept off of stdout so they do not mix with the data output of commands (such as inspect or get-creds).
+func setupEvents() {
+	// Spinners and progress bars only add noise when the output is being consumed by a machine
+	message.NoProgress = true
+
+	if LogOutputCLI == "" {
+		message.EnableEvents(os.Stderr)
+		return
+	}
+
+	eventFile, err := os.OpenFile(LogOutputCLI, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		message.WarnErrf(err, lang.RootCmdErrLogOutput, LogOutputCLI)
+		message.EnableEvents(os.Stderr)
+		return
+	}
+	message.EnableEvents(eventFile)
+}
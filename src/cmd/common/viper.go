@@ -20,6 +20,8 @@ const (
 	// Root config keys
 
 	VLogLevel     = "log_level"
+	VLogFormat    = "log_format"
+	VLogOutput    = "log_output"
 	VArchitecture = "architecture"
 	VNoLogFile    = "no_log_file"
 	VNoProgress   = "no_progress"
@@ -32,6 +34,7 @@ const (
 
 	VInitComponents   = "init.components"
 	VInitStorageClass = "init.storage_class"
+	VInitImageVerify  = "init.image_verify_key"
 
 	// Init Git config keys
 
@@ -75,6 +78,9 @@ const (
 	VPkgCreateDifferential       = "package.create.differential"
 	VPkgCreateRegistryOverride   = "package.create.registry_override"
 	VPkgCreateFlavor             = "package.create.flavor"
+	VPkgCreateImagePlatforms     = "package.create.image_platforms"
+	VPkgCreateVulnDB             = "package.create.vuln_db"
+	VPkgCreateFailOn             = "package.create.fail_on"
 
 	// Package deploy config keys
 
@@ -84,6 +90,27 @@ const (
 	VPkgDeploySget         = "package.deploy.sget"
 	VPkgDeploySkipWebhooks = "package.deploy.skip_webhooks"
 	VPkgDeployTimeout      = "package.deploy.timeout"
+	VPkgDeployAtomic       = "package.deploy.atomic"
+	VPkgDeployPlan         = "package.deploy.plan"
+	VPkgDeployConcurrency  = "package.deploy.concurrency"
+	VPkgDeployImagePush    = "package.deploy.image_push_concurrency"
+	VPkgDeployResume       = "package.deploy.resume"
+	VPkgDeployImageVerify  = "package.deploy.image_verify_key"
+	VPkgDeployVariablesKey = "package.deploy.variables_key"
+
+	// Bundle deploy config keys
+
+	VBundleDeploySet          = "bundle.deploy.set"
+	VBundleDeploySkipWebhooks = "bundle.deploy.skip_webhooks"
+	VBundleDeployTimeout      = "bundle.deploy.timeout"
+	VBundleDeployAtomic       = "bundle.deploy.atomic"
+	VBundleDeployConcurrency  = "bundle.deploy.concurrency"
+	VBundleDeployImagePush    = "bundle.deploy.image_push_concurrency"
+	VBundleDeployResume       = "bundle.deploy.resume"
+
+	// Package remove config keys
+
+	VPkgRemovePruneRepos = "package.remove.prune_repos"
 
 	// Package publish config keys
 
@@ -180,6 +207,7 @@ func printViperConfigUsed() {
 func setDefaults() {
 	// Root defaults that are non-zero values
 	v.SetDefault(VLogLevel, "info")
+	v.SetDefault(VLogFormat, "text")
 	v.SetDefault(VZarfCache, config.ZarfDefaultCachePath)
 
 	// Package defaults that are non-zero values
@@ -187,4 +215,10 @@ func setDefaults() {
 
 	// Deploy opts that are non-zero values
 	v.SetDefault(VPkgDeployTimeout, config.ZarfDefaultHelmTimeout)
+	v.SetDefault(VPkgDeployConcurrency, 1)
+	v.SetDefault(VPkgDeployImagePush, 3)
+
+	v.SetDefault(VBundleDeployTimeout, config.ZarfDefaultHelmTimeout)
+	v.SetDefault(VBundleDeployConcurrency, 1)
+	v.SetDefault(VBundleDeployImagePush, 3)
 }
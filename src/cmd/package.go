@@ -91,6 +91,33 @@ var packageDeployCmd = &cobra.Command{
 	},
 }
 
+var packageUpgradeCmd = &cobra.Command{
+	Use:     "upgrade [ PACKAGE_SOURCE ]",
+	Aliases: []string{"u"},
+	Short:   lang.CmdPackageUpgradeShort,
+	Long:    lang.CmdPackageUpgradeLong,
+	Args:    cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		pkgConfig.PkgOpts.PackageSource = choosePackage(args)
+
+		// Ensure uppercase keys from viper and CLI --set
+		v := common.GetViper()
+
+		// Merge the viper config file variables and provided CLI flag variables (CLI takes precedence))
+		pkgConfig.PkgOpts.SetVariables = helpers.TransformAndMergeMap(
+			v.GetStringMapString(common.VPkgDeploySet), pkgConfig.PkgOpts.SetVariables, strings.ToUpper)
+
+		// Configure the packager
+		pkgClient := packager.NewOrDie(&pkgConfig)
+		defer pkgClient.ClearTempPaths()
+
+		// Upgrade the package
+		if err := pkgClient.Upgrade(); err != nil {
+			message.Fatalf(err, lang.CmdPackageUpgradeErr, err.Error())
+		}
+	},
+}
+
 var packageMirrorCmd = &cobra.Command{
 	Use:     "mirror-resources [ PACKAGE_SOURCE ]",
 	Aliases: []string{"mr"},
@@ -192,6 +219,61 @@ var packageRemoveCmd = &cobra.Command{
 	ValidArgsFunction: getPackageCompletionArgs,
 }
 
+var packageVerifyCmd = &cobra.Command{
+	Use:   "verify PACKAGE_NAME",
+	Args:  cobra.ExactArgs(1),
+	Short: lang.CmdPackageVerifyShort,
+	Long:  lang.CmdPackageVerifyLong,
+	Run: func(cmd *cobra.Command, args []string) {
+		pkgConfig.PkgOpts.PackageSource = args[0]
+
+		src, err := sources.NewClusterSource(&pkgConfig.PkgOpts)
+		if err != nil {
+			message.Fatalf(err, lang.CmdPackageInvalidSource, pkgConfig.PkgOpts.PackageSource, err.Error())
+		}
+
+		// Configure the packager
+		pkgClient := packager.NewOrDie(&pkgConfig, packager.WithSource(src))
+		defer pkgClient.ClearTempPaths()
+
+		if err := pkgClient.Verify(); err != nil {
+			message.Fatalf(err, lang.CmdPackageVerifyErr, err.Error())
+		}
+	},
+	ValidArgsFunction: getPackageCompletionArgs,
+}
+
+var packageVarsCmd = &cobra.Command{
+	Use:     "vars PACKAGE_NAME",
+	Args:    cobra.ExactArgs(1),
+	Short:   lang.CmdPackageVarsShort,
+	Long:    lang.CmdPackageVarsLong,
+	Example: lang.CmdPackageVarsExample,
+	Run: func(cmd *cobra.Command, args []string) {
+		pkgConfig.PkgOpts.PackageSource = args[0]
+
+		// Ensure uppercase variable names from the CLI
+		pkgConfig.VarsOpts.SetVariables = helpers.TransformMapKeys(pkgConfig.VarsOpts.SetVariables, strings.ToUpper)
+		for idx, name := range pkgConfig.VarsOpts.UnsetVariables {
+			pkgConfig.VarsOpts.UnsetVariables[idx] = strings.ToUpper(name)
+		}
+
+		src, err := sources.NewClusterSource(&pkgConfig.PkgOpts)
+		if err != nil {
+			message.Fatalf(err, lang.CmdPackageInvalidSource, pkgConfig.PkgOpts.PackageSource, err.Error())
+		}
+
+		// Configure the packager
+		pkgClient := packager.NewOrDie(&pkgConfig, packager.WithSource(src))
+		defer pkgClient.ClearTempPaths()
+
+		if err := pkgClient.Vars(); err != nil {
+			message.Fatalf(err, lang.CmdPackageVarsErr, err.Error())
+		}
+	},
+	ValidArgsFunction: getPackageCompletionArgs,
+}
+
 var packagePublishCmd = &cobra.Command{
 	Use:     "publish { PACKAGE_SOURCE | SKELETON DIRECTORY } REPOSITORY",
 	Short:   lang.CmdPackagePublishShort,
@@ -312,9 +394,12 @@ func init() {
 	rootCmd.AddCommand(packageCmd)
 	packageCmd.AddCommand(packageCreateCmd)
 	packageCmd.AddCommand(packageDeployCmd)
+	packageCmd.AddCommand(packageUpgradeCmd)
 	packageCmd.AddCommand(packageMirrorCmd)
 	packageCmd.AddCommand(packageInspectCmd)
 	packageCmd.AddCommand(packageRemoveCmd)
+	packageCmd.AddCommand(packageVerifyCmd)
+	packageCmd.AddCommand(packageVarsCmd)
 	packageCmd.AddCommand(packageListCmd)
 	packageCmd.AddCommand(packagePublishCmd)
 	packageCmd.AddCommand(packagePullCmd)
@@ -322,9 +407,11 @@ func init() {
 	bindPackageFlags(v)
 	bindCreateFlags(v)
 	bindDeployFlags(v)
+	bindUpgradeFlags(v)
 	bindMirrorFlags(v)
 	bindInspectFlags(v)
 	bindRemoveFlags(v)
+	bindVarsFlags(v)
 	bindPublishFlags(v)
 	bindPullFlags(v)
 }
@@ -357,6 +444,9 @@ func bindCreateFlags(v *viper.Viper) {
 	createFlags.IntVarP(&pkgConfig.CreateOpts.MaxPackageSizeMB, "max-package-size", "m", v.GetInt(common.VPkgCreateMaxPackageSize), lang.CmdPackageCreateFlagMaxPackageSize)
 	createFlags.StringToStringVar(&pkgConfig.CreateOpts.RegistryOverrides, "registry-override", v.GetStringMapString(common.VPkgCreateRegistryOverride), lang.CmdPackageCreateFlagRegistryOverride)
 	createFlags.StringVarP(&pkgConfig.CreateOpts.Flavor, "flavor", "f", v.GetString(common.VPkgCreateFlavor), lang.CmdPackageCreateFlagFlavor)
+	createFlags.StringSliceVar(&pkgConfig.CreateOpts.ImagePlatforms, "image-platforms", v.GetStringSlice(common.VPkgCreateImagePlatforms), lang.CmdPackageCreateFlagImagePlatforms)
+	createFlags.StringVar(&pkgConfig.CreateOpts.VulnDBPath, "vuln-db", v.GetString(common.VPkgCreateVulnDB), lang.CmdPackageCreateFlagVulnDB)
+	createFlags.StringVar(&pkgConfig.CreateOpts.FailOnSeverity, "fail-on", v.GetString(common.VPkgCreateFailOn), lang.CmdPackageCreateFlagFailOn)
 
 	createFlags.StringVar(&pkgConfig.CreateOpts.SigningKeyPath, "signing-key", v.GetString(common.VPkgCreateSigningKey), lang.CmdPackageCreateFlagSigningKey)
 	createFlags.StringVar(&pkgConfig.CreateOpts.SigningKeyPassword, "signing-key-pass", v.GetString(common.VPkgCreateSigningKeyPassword), lang.CmdPackageCreateFlagSigningKeyPassword)
@@ -381,8 +471,15 @@ func bindDeployFlags(v *viper.Viper) {
 	deployFlags.BoolVar(&pkgConfig.DeployOpts.SkipWebhooks, "skip-webhooks", v.GetBool(common.VPkgDeploySkipWebhooks), lang.CmdPackageDeployFlagSkipWebhooks)
 
 	deployFlags.DurationVar(&pkgConfig.DeployOpts.Timeout, "timeout", v.GetDuration(common.VPkgDeployTimeout), lang.CmdPackageDeployFlagTimeout)
+	deployFlags.BoolVar(&pkgConfig.DeployOpts.Atomic, "atomic", v.GetBool(common.VPkgDeployAtomic), lang.CmdPackageDeployFlagAtomic)
+	deployFlags.BoolVar(&pkgConfig.DeployOpts.Plan, "plan", v.GetBool(common.VPkgDeployPlan), lang.CmdPackageDeployFlagPlan)
+	deployFlags.IntVar(&pkgConfig.DeployOpts.Concurrency, "concurrency", v.GetInt(common.VPkgDeployConcurrency), lang.CmdPackageDeployFlagConcurrency)
+	deployFlags.IntVar(&pkgConfig.DeployOpts.ImagePushConcurrency, "image-push-concurrency", v.GetInt(common.VPkgDeployImagePush), lang.CmdPackageDeployFlagImagePushConcurrency)
+	deployFlags.BoolVar(&pkgConfig.DeployOpts.Resume, "resume", v.GetBool(common.VPkgDeployResume), lang.CmdPackageDeployFlagResume)
+	deployFlags.StringSliceVar(&pkgConfig.DeployOpts.ImageVerifyKeys, "image-verify-key", v.GetStringSlice(common.VPkgDeployImageVerify), lang.CmdPackageDeployFlagImageVerifyKey)
 
 	deployFlags.StringToStringVar(&pkgConfig.PkgOpts.SetVariables, "set", v.GetStringMapString(common.VPkgDeploySet), lang.CmdPackageDeployFlagSet)
+	deployFlags.StringVar(&pkgConfig.PkgOpts.VariablesKey, "variables-key", v.GetString(common.VPkgDeployVariablesKey), lang.CmdPackageDeployFlagVariablesKey)
 	deployFlags.StringVar(&pkgConfig.PkgOpts.OptionalComponents, "components", v.GetString(common.VPkgDeployComponents), lang.CmdPackageDeployFlagComponents)
 	deployFlags.StringVar(&pkgConfig.PkgOpts.Shasum, "shasum", v.GetString(common.VPkgDeployShasum), lang.CmdPackageDeployFlagShasum)
 	deployFlags.StringVar(&pkgConfig.PkgOpts.SGetKeyPath, "sget", v.GetString(common.VPkgDeploySget), lang.CmdPackageDeployFlagSget)
@@ -390,6 +487,31 @@ func bindDeployFlags(v *viper.Viper) {
 	deployFlags.MarkHidden("sget")
 }
 
+func bindUpgradeFlags(v *viper.Viper) {
+	upgradeFlags := packageUpgradeCmd.Flags()
+
+	// Always require confirm flag (no viper)
+	upgradeFlags.BoolVar(&config.CommonOptions.Confirm, "confirm", false, lang.CmdPackageDeployFlagConfirm)
+
+	// Always require adopt-existing-resources flag (no viper)
+	upgradeFlags.BoolVar(&pkgConfig.DeployOpts.AdoptExistingResources, "adopt-existing-resources", false, lang.CmdPackageDeployFlagAdoptExistingResources)
+
+	upgradeFlags.BoolVar(&pkgConfig.DeployOpts.SkipWebhooks, "skip-webhooks", v.GetBool(common.VPkgDeploySkipWebhooks), lang.CmdPackageDeployFlagSkipWebhooks)
+
+	upgradeFlags.DurationVar(&pkgConfig.DeployOpts.Timeout, "timeout", v.GetDuration(common.VPkgDeployTimeout), lang.CmdPackageDeployFlagTimeout)
+	upgradeFlags.BoolVar(&pkgConfig.DeployOpts.Atomic, "atomic", v.GetBool(common.VPkgDeployAtomic), lang.CmdPackageDeployFlagAtomic)
+	upgradeFlags.BoolVar(&pkgConfig.DeployOpts.Plan, "plan", v.GetBool(common.VPkgDeployPlan), lang.CmdPackageDeployFlagPlan)
+	upgradeFlags.IntVar(&pkgConfig.DeployOpts.Concurrency, "concurrency", v.GetInt(common.VPkgDeployConcurrency), lang.CmdPackageDeployFlagConcurrency)
+	upgradeFlags.IntVar(&pkgConfig.DeployOpts.ImagePushConcurrency, "image-push-concurrency", v.GetInt(common.VPkgDeployImagePush), lang.CmdPackageDeployFlagImagePushConcurrency)
+	upgradeFlags.BoolVar(&pkgConfig.DeployOpts.Resume, "resume", v.GetBool(common.VPkgDeployResume), lang.CmdPackageDeployFlagResume)
+	upgradeFlags.StringSliceVar(&pkgConfig.DeployOpts.ImageVerifyKeys, "image-verify-key", v.GetStringSlice(common.VPkgDeployImageVerify), lang.CmdPackageDeployFlagImageVerifyKey)
+
+	upgradeFlags.StringToStringVar(&pkgConfig.PkgOpts.SetVariables, "set", v.GetStringMapString(common.VPkgDeploySet), lang.CmdPackageDeployFlagSet)
+	upgradeFlags.StringVar(&pkgConfig.PkgOpts.VariablesKey, "variables-key", v.GetString(common.VPkgDeployVariablesKey), lang.CmdPackageDeployFlagVariablesKey)
+	upgradeFlags.StringVar(&pkgConfig.PkgOpts.OptionalComponents, "components", v.GetString(common.VPkgDeployComponents), lang.CmdPackageDeployFlagComponents)
+	upgradeFlags.StringVar(&pkgConfig.PkgOpts.Shasum, "shasum", v.GetString(common.VPkgDeployShasum), lang.CmdPackageDeployFlagShasum)
+}
+
 func bindMirrorFlags(v *viper.Viper) {
 	mirrorFlags := packageMirrorCmd.Flags()
 
@@ -420,15 +542,24 @@ func bindInspectFlags(_ *viper.Viper) {
 	inspectFlags := packageInspectCmd.Flags()
 	inspectFlags.BoolVarP(&pkgConfig.InspectOpts.ViewSBOM, "sbom", "s", false, lang.CmdPackageInspectFlagSbom)
 	inspectFlags.StringVar(&pkgConfig.InspectOpts.SBOMOutputDir, "sbom-out", "", lang.CmdPackageInspectFlagSbomOut)
+	inspectFlags.BoolVar(&pkgConfig.InspectOpts.ViewVulns, "vulns", false, lang.CmdPackageInspectFlagVulns)
 }
 
 func bindRemoveFlags(v *viper.Viper) {
 	removeFlags := packageRemoveCmd.Flags()
 	removeFlags.BoolVar(&config.CommonOptions.Confirm, "confirm", false, lang.CmdPackageRemoveFlagConfirm)
 	removeFlags.StringVar(&pkgConfig.PkgOpts.OptionalComponents, "components", v.GetString(common.VPkgDeployComponents), lang.CmdPackageRemoveFlagComponents)
+	removeFlags.BoolVar(&pkgConfig.RemoveOpts.PruneRepos, "prune-repos", v.GetBool(common.VPkgRemovePruneRepos), lang.CmdPackageRemoveFlagPruneRepos)
 	_ = packageRemoveCmd.MarkFlagRequired("confirm")
 }
 
+func bindVarsFlags(v *viper.Viper) {
+	varsFlags := packageVarsCmd.Flags()
+	varsFlags.StringToStringVar(&pkgConfig.VarsOpts.SetVariables, "set", map[string]string{}, lang.CmdPackageVarsFlagSet)
+	varsFlags.StringSliceVar(&pkgConfig.VarsOpts.UnsetVariables, "unset", []string{}, lang.CmdPackageVarsFlagUnset)
+	varsFlags.StringVar(&pkgConfig.PkgOpts.VariablesKey, "variables-key", v.GetString(common.VPkgDeployVariablesKey), lang.CmdPackageDeployFlagVariablesKey)
+}
+
 func bindPublishFlags(v *viper.Viper) {
 	publishFlags := packagePublishCmd.Flags()
 	publishFlags.StringVar(&pkgConfig.PublishOpts.SigningKeyPath, "signing-key", v.GetString(common.VPkgPublishSigningKey), lang.CmdPackagePublishFlagSigningKey)
@@ -14,7 +14,7 @@ import (
 	"github.com/defenseunicorns/zarf/src/config/lang"
 	"github.com/defenseunicorns/zarf/src/pkg/cluster"
 	"github.com/defenseunicorns/zarf/src/pkg/message"
-	"github.com/defenseunicorns/zarf/src/pkg/transform"
+	"github.com/defenseunicorns/zarf/src/pkg/utils"
 	"github.com/defenseunicorns/zarf/src/pkg/utils/exec"
 	"github.com/defenseunicorns/zarf/src/types"
 	craneCmd "github.com/google/go-containerregistry/cmd/crane/cmd"
@@ -24,6 +24,8 @@ import (
 	"github.com/spf13/cobra"
 )
 
+var pruneDryRun bool
+
 func init() {
 	verbose := false
 	insecure := false
@@ -71,11 +73,13 @@ func init() {
 		Use:     "prune",
 		Aliases: []string{"p"},
 		Short:   lang.CmdToolsRegistryPruneShort,
+		Long:    lang.CmdToolsRegistryPruneLong,
 		RunE:    pruneImages,
 	}
 
 	// Always require confirm flag (no viper)
 	pruneCmd.Flags().BoolVar(&config.CommonOptions.Confirm, "confirm", false, lang.CmdToolsRegistryPruneFlagConfirm)
+	pruneCmd.Flags().BoolVar(&pruneDryRun, "dry-run", false, lang.CmdToolsRegistryPruneFlagDryRun)
 
 	craneLogin := craneCmd.NewCmdAuthLogin()
 	craneLogin.Example = ""
@@ -222,12 +226,6 @@ func pruneImages(_ *cobra.Command, _ []string) error {
 		return err
 	}
 
-	// Load the currently deployed packages
-	zarfPackages, errs := c.GetDeployedZarfPackages()
-	if len(errs) > 0 {
-		return lang.ErrUnableToGetPackages
-	}
-
 	// Set up a tunnel to the registry if applicable
 	registryEndpoint, tunnel, err := c.ConnectToZarfRegistryEndpoint(zarfState.RegistryInfo)
 	if err != nil {
@@ -237,108 +235,57 @@ func pruneImages(_ *cobra.Command, _ []string) error {
 	if tunnel != nil {
 		message.Notef(lang.CmdToolsRegistryTunnel, registryEndpoint, zarfState.RegistryInfo.Address)
 		defer tunnel.Close()
-		return tunnel.Wrap(func() error { return doPruneImagesForPackages(zarfState, zarfPackages, registryEndpoint) })
+		return tunnel.Wrap(func() error { return doPruneImages(c, zarfState.RegistryInfo, registryEndpoint) })
 	}
 
-	return doPruneImagesForPackages(zarfState, zarfPackages, registryEndpoint)
+	return doPruneImages(c, zarfState.RegistryInfo, registryEndpoint)
 }
 
-func doPruneImagesForPackages(zarfState *types.ZarfState, zarfPackages []types.DeployedPackage, registryEndpoint string) error {
-	authOption := config.GetCraneAuthOption(zarfState.RegistryInfo.PushUsername, zarfState.RegistryInfo.PushPassword)
-
-	// Determine which image digests are currently used by Zarf packages
-	pkgImages := map[string]bool{}
-	for _, pkg := range zarfPackages {
-		deployedComponents := map[string]bool{}
-		for _, depComponent := range pkg.DeployedComponents {
-			deployedComponents[depComponent.Name] = true
-		}
-
-		for _, component := range pkg.Data.Components {
-			if _, ok := deployedComponents[component.Name]; ok {
-				for _, image := range component.Images {
-					// We use the no checksum image since it will always exist and will share the same digest with other tags
-					transformedImageNoCheck, err := transform.ImageTransformHostWithoutChecksum(registryEndpoint, image)
-					if err != nil {
-						return err
-					}
-
-					digest, err := crane.Digest(transformedImageNoCheck, authOption)
-					if err != nil {
-						return err
-					}
-					pkgImages[digest] = true
-				}
-			}
-		}
-	}
-
-	// Find which images and tags are in the registry currently
-	imageCatalog, err := crane.Catalog(registryEndpoint, authOption)
+func doPruneImages(c *cluster.Cluster, registryInfo types.RegistryInfo, registryEndpoint string) error {
+	// Find the images that no deployed package or pod references
+	garbage, err := c.FindRegistryGarbage(registryInfo, registryEndpoint)
 	if err != nil {
 		return err
 	}
-	referenceToDigest := map[string]string{}
-	for _, image := range imageCatalog {
-		imageRef := fmt.Sprintf("%s/%s", registryEndpoint, image)
-		tags, err := crane.ListTags(imageRef, authOption)
-		if err != nil {
-			return err
-		}
-		for _, tag := range tags {
-			taggedImageRef := fmt.Sprintf("%s:%s", imageRef, tag)
-			digest, err := crane.Digest(taggedImageRef, authOption)
-			if err != nil {
-				return err
-			}
-			referenceToDigest[taggedImageRef] = digest
-		}
-	}
 
-	// Figure out which images are in the registry but not needed by packages
-	imageDigestsToPrune := map[string]bool{}
-	for digestRef, digest := range referenceToDigest {
-		if _, ok := pkgImages[digest]; !ok {
-			refInfo, err := transform.ParseImageRef(digestRef)
-			if err != nil {
-				return err
-			}
-			digestRef = fmt.Sprintf("%s@%s", refInfo.Name, digest)
-			imageDigestsToPrune[digestRef] = true
-		}
+	if len(garbage.References) == 0 {
+		message.Note(lang.CmdToolsRegistryPruneNoImages)
+		return nil
 	}
 
-	if len(imageDigestsToPrune) > 0 {
-		message.Note(lang.CmdToolsRegistryPruneImageList)
+	message.Note(lang.CmdToolsRegistryPruneImageList)
+	for _, digestRef := range garbage.References {
+		message.Info(digestRef)
+	}
+	message.Notef(lang.CmdToolsRegistryPruneReclaimable, utils.ByteFormat(float64(garbage.ReclaimableBytes), 2))
 
-		for digestRef := range imageDigestsToPrune {
-			message.Info(digestRef)
-		}
+	if pruneDryRun {
+		message.Note(lang.CmdToolsRegistryPruneDryRun)
+		return nil
+	}
 
-		confirm := config.CommonOptions.Confirm
+	confirm := config.CommonOptions.Confirm
 
-		if confirm {
-			message.Note(lang.CmdConfirmProvided)
-		} else {
-			prompt := &survey.Confirm{
-				Message: lang.CmdConfirmContinue,
-			}
-			if err := survey.AskOne(prompt, &confirm); err != nil {
-				message.Fatalf(nil, lang.ErrConfirmCancel, err)
-			}
+	if confirm {
+		message.Note(lang.CmdConfirmProvided)
+	} else {
+		prompt := &survey.Confirm{
+			Message: lang.CmdConfirmContinue,
 		}
-		if confirm {
-			// Delete the digest references that are to be pruned
-			for digestRef := range imageDigestsToPrune {
-				err = crane.Delete(digestRef, authOption)
-				if err != nil {
-					return err
-				}
-			}
+		if err := survey.AskOne(prompt, &confirm); err != nil {
+			message.Fatalf(nil, lang.ErrConfirmCancel, err)
 		}
-	} else {
-		message.Note(lang.CmdToolsRegistryPruneNoImages)
 	}
+	if !confirm {
+		return nil
+	}
+
+	// Delete the digest references that are to be pruned
+	if err := cluster.DeleteRegistryGarbage(registryInfo, garbage); err != nil {
+		return err
+	}
+
+	message.Notef(lang.CmdToolsRegistryPruneReclaimed, utils.ByteFormat(float64(garbage.ReclaimableBytes), 2))
 
 	return nil
 }
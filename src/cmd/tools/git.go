@@ -0,0 +1,128 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package tools contains the CLI commands for Zarf.
+package tools
+
+import (
+	"fmt"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/defenseunicorns/zarf/src/config"
+	"github.com/defenseunicorns/zarf/src/config/lang"
+	"github.com/defenseunicorns/zarf/src/internal/packager/git"
+	"github.com/defenseunicorns/zarf/src/pkg/cluster"
+	"github.com/defenseunicorns/zarf/src/pkg/message"
+	"github.com/spf13/cobra"
+)
+
+var gitPruneDryRun bool
+var gitPruneArchive bool
+
+var gitCmd = &cobra.Command{
+	Use:   "git",
+	Short: lang.CmdToolsGitShort,
+}
+
+var gitPruneCmd = &cobra.Command{
+	Use:     "prune",
+	Aliases: []string{"p"},
+	Short:   lang.CmdToolsGitPruneShort,
+	RunE:    pruneRepos,
+}
+
+func init() {
+	toolsCmd.AddCommand(gitCmd)
+	gitCmd.AddCommand(gitPruneCmd)
+
+	// Always require confirm flag (no viper)
+	gitPruneCmd.Flags().BoolVar(&config.CommonOptions.Confirm, "confirm", false, lang.CmdToolsGitPruneFlagConfirm)
+	gitPruneCmd.Flags().BoolVar(&gitPruneDryRun, "dry-run", false, lang.CmdToolsGitPruneFlagDryRun)
+	gitPruneCmd.Flags().BoolVar(&gitPruneArchive, "archive", false, lang.CmdToolsGitPruneFlagArchive)
+}
+
+func pruneRepos(_ *cobra.Command, _ []string) error {
+	// Try to connect to a Zarf initialized cluster
+	c, err := cluster.NewCluster()
+	if err != nil {
+		return err
+	}
+
+	// Load the state
+	zarfState, err := c.LoadZarfState()
+	if err != nil {
+		return err
+	}
+
+	if !zarfState.GitServer.InternalServer {
+		return fmt.Errorf(lang.CmdToolsGitPruneErrExternal)
+	}
+
+	// Load the currently deployed packages
+	zarfPackages, errs := c.GetDeployedZarfPackages()
+	if len(errs) > 0 {
+		return lang.ErrUnableToGetPackages
+	}
+
+	gitClient := git.New(zarfState.GitServer)
+
+	repos, err := gitClient.GetRepos()
+	if err != nil {
+		return err
+	}
+
+	// Already archived repos have nothing left to archive
+	candidates := []string{}
+	for _, repo := range repos {
+		if !gitPruneArchive || !repo.Archived {
+			candidates = append(candidates, repo.Name)
+		}
+	}
+
+	reposToPrune := git.GetUnreferencedRepos(candidates, zarfPackages)
+	if len(reposToPrune) == 0 {
+		message.Note(lang.CmdToolsGitPruneNoRepos)
+		return nil
+	}
+
+	if gitPruneArchive {
+		message.Note(lang.CmdToolsGitPruneArchiveRepoList)
+	} else {
+		message.Note(lang.CmdToolsGitPruneRepoList)
+	}
+	for _, repoName := range reposToPrune {
+		message.Info(repoName)
+	}
+
+	if gitPruneDryRun {
+		message.Note(lang.CmdToolsGitPruneDryRun)
+		return nil
+	}
+
+	confirm := config.CommonOptions.Confirm
+
+	if confirm {
+		message.Note(lang.CmdConfirmProvided)
+	} else {
+		prompt := &survey.Confirm{
+			Message: lang.CmdConfirmContinue,
+		}
+		if err := survey.AskOne(prompt, &confirm); err != nil {
+			message.Fatalf(nil, lang.ErrConfirmCancel, err)
+		}
+	}
+	if !confirm {
+		return nil
+	}
+
+	spinner := message.NewProgressSpinner("Pruning %d repos from the Zarf git server", len(reposToPrune))
+	defer spinner.Stop()
+
+	if err := gitClient.PruneRepos(reposToPrune, gitPruneArchive); err != nil {
+		return err
+	}
+
+	spinner.Successf("Pruned %d repos from the Zarf git server", len(reposToPrune))
+
+	return nil
+}
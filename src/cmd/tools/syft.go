@@ -5,12 +5,55 @@
 package tools
 
 import (
+	"strings"
+
 	"github.com/anchore/clio"
 	syftCLI "github.com/anchore/syft/cmd/syft/cli"
 	"github.com/defenseunicorns/zarf/src/config"
 	"github.com/defenseunicorns/zarf/src/config/lang"
+	"github.com/defenseunicorns/zarf/src/internal/packager/sbom"
+	"github.com/defenseunicorns/zarf/src/pkg/cluster"
+	"github.com/defenseunicorns/zarf/src/pkg/message"
+	"github.com/defenseunicorns/zarf/src/pkg/utils"
+	"github.com/spf13/cobra"
 )
 
+var rescanVulnDBPath string
+var rescanFailOn string
+var rescanOutput string
+
+var sbomRescanCmd = &cobra.Command{
+	Use:     "rescan",
+	Short:   lang.CmdToolsSbomRescanShort,
+	Long:    lang.CmdToolsSbomRescanLong,
+	Example: lang.CmdToolsSbomRescanExample,
+	Args:    cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		if rescanFailOn != "" && !sbom.IsValidSeverity(rescanFailOn) {
+			message.Fatalf(nil, lang.CmdToolsSbomRescanErrFailOn, rescanFailOn, strings.Join(sbom.Severities, ", "))
+		}
+
+		reports, err := sbom.Rescan(cluster.NewClusterOrDie(), rescanVulnDBPath)
+		if err != nil {
+			message.Fatalf(err, lang.CmdToolsSbomRescanErr, err.Error())
+		}
+
+		sbom.PrintRescanReports(reports, rescanFailOn)
+
+		if rescanOutput != "" {
+			if err := utils.WriteJSON(rescanOutput, reports); err != nil {
+				message.Fatalf(err, lang.CmdToolsSbomRescanErrOutput, rescanOutput, err.Error())
+			}
+		}
+
+		if rescanFailOn != "" {
+			if count := sbom.CountPackageFindings(reports, rescanFailOn); count > 0 {
+				message.Fatalf(nil, lang.CmdToolsSbomRescanErrVulnerabilities, count, rescanFailOn)
+			}
+		}
+	},
+}
+
 func init() {
 	syftCmd := syftCLI.Command(clio.Identification{
 		Name:    "syft",
@@ -25,5 +68,11 @@ func init() {
 		subCmd.Example = ""
 	}
 
+	sbomRescanCmd.Flags().StringVar(&rescanVulnDBPath, "vuln-db", "", lang.CmdToolsSbomRescanFlagVulnDB)
+	sbomRescanCmd.Flags().StringVar(&rescanFailOn, "fail-on", "", lang.CmdToolsSbomRescanFlagFailOn)
+	sbomRescanCmd.Flags().StringVar(&rescanOutput, "output", "", lang.CmdToolsSbomRescanFlagOutput)
+	sbomRescanCmd.MarkFlagRequired("vuln-db")
+	syftCmd.AddCommand(sbomRescanCmd)
+
 	toolsCmd.AddCommand(syftCmd)
 }
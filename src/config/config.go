@@ -41,9 +41,10 @@ const (
 
 	ZarfPackagePrefix = "zarf-package-"
 
-	ZarfDeployStage = "Deploy"
-	ZarfCreateStage = "Create"
-	ZarfMirrorStage = "Mirror"
+	ZarfDeployStage  = "Deploy"
+	ZarfUpgradeStage = "Upgrade"
+	ZarfCreateStage  = "Create"
+	ZarfMirrorStage  = "Mirror"
 )
 
 // Zarf Constants for In-Cluster Services.
@@ -56,6 +57,7 @@ const (
 	ZarfRegistryPushUser                   = "zarf-push"
 	ZarfRegistryPullUser                   = "zarf-pull"
 	ZarfInClusterContainerRegistryNodePort = 31999
+	ZarfInClusterContainerRegistryHost     = "zarf-docker-registry.zarf.svc.cluster.local:5000"
 
 	ZarfGitPushUser = "zarf-git-user"
 	ZarfGitReadUser = "zarf-git-read-user"
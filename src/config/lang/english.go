@@ -34,7 +34,9 @@ const (
 
 // Lint messages
 const (
-	UnsetVarLintWarning = "There are templates that are not set and won't be evaluated during lint"
+	UnsetVarLintWarning           = "There are templates that are not set and won't be evaluated during lint"
+	InvalidVarDefaultLintError    = "Variable default is not a valid %s: %s"
+	InvalidVarDefinitionLintError = "Variable type definition is invalid: %s"
 )
 
 // Zarf CLI commands.
@@ -49,6 +51,8 @@ const (
 		"using a declarative packaging strategy to support DevSecOps in offline and semi-connected environments."
 
 	RootCmdFlagLogLevel    = "Log level when running Zarf. Valid options are: warn, info, debug, trace"
+	RootCmdFlagLogFormat   = "Log format when running Zarf. Valid options are: text, json (json also writes structured events for package operations as JSON lines)"
+	RootCmdFlagLogOutput   = "File to write the JSON events of --log-format=json to instead of stderr"
 	RootCmdFlagArch        = "Architecture for OCI images and Zarf packages"
 	RootCmdFlagSkipLogFile = "Disable log file creation"
 	RootCmdFlagNoProgress  = "Disable fancy UI progress bars, spinners, logos, etc"
@@ -60,7 +64,9 @@ const (
 	RootCmdDeprecatedDeploy = "Deprecated: Please use \"zarf package deploy %s\" to deploy this package.  This warning will be removed in Zarf v1.0.0."
 	RootCmdDeprecatedCreate = "Deprecated: Please use \"zarf package create\" to create this package.  This warning will be removed in Zarf v1.0.0."
 
-	RootCmdErrInvalidLogLevel = "Invalid log level. Valid options are: warn, info, debug, trace."
+	RootCmdErrInvalidLogLevel  = "Invalid log level. Valid options are: warn, info, debug, trace."
+	RootCmdErrInvalidLogFormat = "Invalid log format. Valid options are: text, json."
+	RootCmdErrLogOutput        = "Unable to open the event log output %s, writing events to stderr instead"
 
 	// zarf connect
 	CmdConnectShort = "Accesses services or pods deployed in the cluster"
@@ -162,9 +168,10 @@ $ zarf init --artifact-push-password={PASSWORD} --artifact-push-username={USERNA
 
 	CmdInitFlagSet = "Specify deployment variables to set on the command line (KEY=value)"
 
-	CmdInitFlagConfirm      = "Confirms package deployment without prompting. ONLY use with packages you trust. Skips prompts to review SBOM, configure variables, select optional components and review potential breaking changes."
-	CmdInitFlagComponents   = "Specify which optional components to install.  E.g. --components=git-server,logging"
-	CmdInitFlagStorageClass = "Specify the storage class to use for the registry and git server.  E.g. --storage-class=standard"
+	CmdInitFlagConfirm        = "Confirms package deployment without prompting. ONLY use with packages you trust. Skips prompts to review SBOM, configure variables, select optional components and review potential breaking changes."
+	CmdInitFlagComponents     = "Specify which optional components to install.  E.g. --components=git-server,logging"
+	CmdInitFlagStorageClass   = "Specify the storage class to use for the registry and git server.  E.g. --storage-class=standard"
+	CmdInitFlagImageVerifyKey = "Path to a cosign public key that the Zarf agent requires every image to be signed by before admitting its pods (can be repeated to trust several keys)"
 
 	CmdInitFlagGitURL      = "External git server url to use for this Zarf cluster"
 	CmdInitFlagGitPushUser = "Username to access to the git server Zarf is configured to use. User must be able to create repositories via 'git push'"
@@ -228,6 +235,38 @@ $ zarf init --artifact-push-password={PASSWORD} --artifact-push-username={USERNA
 
 	CmdInternalCrc32Short = "Generates a decimal CRC32 for the given text"
 
+	// zarf bundle
+	CmdBundleShort       = "Zarf bundle commands for deploying several packages together"
+	CmdBundleDeployShort = "Deploys the Zarf packages listed in a bundle file in order with a single confirmation"
+	CmdBundleDeployLong  = "Reads a bundle file listing Zarf packages with their sources, components, variables and public keys and\n" +
+		"deploys them one at a time, ordering them by their dependsOn lists, after a single confirmation.\n" +
+		"Deployment stops at the first package that fails and a summary of every package is printed."
+	CmdBundleDeployExample = `
+# Deploy every package in a bundle file
+$ zarf bundle deploy bundle.yaml --confirm
+
+# Example bundle.yaml
+kind: ZarfBundleConfig
+metadata:
+  name: platform
+packages:
+  - name: database
+    source: zarf-package-database-amd64-1.0.0.tar.zst
+    variables:
+      STORAGE_SIZE: 10Gi
+  - name: app
+    source: oci://registry.enterprise.corp/packages/app:1.0.0-amd64
+    shasum: <package-shasum>
+    publicKey: cosign.pub
+    components:
+      - app
+      - -docs
+    dependsOn:
+      - database
+`
+	CmdBundleDeployFlagSet = "Specify deployment variables to set on the command line (KEY=value) for every package, overriding the variables in the bundle file"
+	CmdBundleDeployErr     = "Failed to deploy bundle: %s"
+
 	// zarf package
 	CmdPackageShort             = "Zarf package commands for creating, deploying, and inspecting packages"
 	CmdPackageFlagConcurrency   = "Number of concurrent layer operations to perform when interacting with a remote package."
@@ -242,6 +281,11 @@ $ zarf init --artifact-push-password={PASSWORD} --artifact-push-username={USERNA
 	CmdPackageDeployLong  = "Unpacks resources and dependencies from a Zarf package archive and deploys them onto the target system.\n" +
 		"Kubernetes clusters are accessed via credentials in your current kubecontext defined in '~/.kube/config'"
 
+	CmdPackageUpgradeShort = "Upgrades a deployed Zarf package to a new version, removing components the new version no longer has (runs offline)"
+	CmdPackageUpgradeLong  = "Deploys a new version of a Zarf package that is already deployed onto the target system and removes the components\n" +
+		"of the deployed version that the new version no longer has, running their onRemove actions.\n" +
+		"Kubernetes clusters are accessed via credentials in your current kubecontext defined in '~/.kube/config'"
+
 	CmdPackageMirrorShort = "Mirrors a Zarf package's internal resources to specified image registries and git repositories"
 	CmdPackageMirrorLong  = "Unpacks resources and dependencies from a Zarf package archive and mirrors them into the specified\n" +
 		"image registries and git repositories within the target environment"
@@ -286,6 +330,9 @@ $ zarf package mirror-resources <your-package.tar.zst> \
 	CmdPackageCreateFlagDifferential          = "[beta] Build a package that only contains the differential changes from local resources and differing remote resources from the specified previously built package"
 	CmdPackageCreateFlagRegistryOverride      = "Specify a map of domains to override on package create when pulling images (e.g. --registry-override docker.io=dockerio-reg.enterprise.intranet)"
 	CmdPackageCreateFlagFlavor                = "The flavor of components to include in the resulting package (i.e. have a matching or empty \"only.flavor\" key)"
+	CmdPackageCreateFlagImagePlatforms        = "Keep these platforms of multi-platform images in the package as an image index alongside the package architecture (e.g. --image-platforms linux/amd64,linux/arm64), or 'all' to keep every platform"
+	CmdPackageCreateFlagVulnDB                = "Path to an offline Grype vulnerability database archive to scan the package SBOMs against, the findings are included in the package"
+	CmdPackageCreateFlagFailOn                = "Fail package creation if the vulnerability scan finds anything at or above this severity (negligible, low, medium, high, critical)"
 	CmdPackageCreateCleanPathErr              = "Invalid characters in Zarf cache path, defaulting to %s"
 	CmdPackageCreateErr                       = "Failed to create package: %s"
 
@@ -297,25 +344,59 @@ $ zarf package mirror-resources <your-package.tar.zst> \
 	CmdPackageDeployFlagSget                           = "[Deprecated] Path to public sget key file for remote packages signed via cosign. This flag will be removed in v1.0.0 please use the --key flag instead."
 	CmdPackageDeployFlagSkipWebhooks                   = "[alpha] Skip waiting for external webhooks to execute as each package component is deployed"
 	CmdPackageDeployFlagTimeout                        = "Timeout for Helm operations such as installs and rollbacks"
+	CmdPackageDeployFlagAtomic                         = "Roll back every Helm release this deployment touched and restore the previous package secret if any component fails to deploy"
+	CmdPackageDeployFlagPlan                           = "Print the resources, images, repos and variables this deployment would change in the cluster without deploying anything"
+	CmdPackageDeployFlagConcurrency                    = "Number of components to deploy at the same time once the components they depend on are deployed (init packages always deploy one component at a time)"
+	CmdPackageDeployFlagImagePushConcurrency           = "Number of images to push to the Zarf registry at the same time"
+	CmdPackageDeployFlagResume                         = "Resume an interrupted deployment of the same package, skipping components whose charts and images are already in the cluster and restarting from the first failed or missing component"
+	CmdPackageDeployFlagImageVerifyKey                 = "Verify that every image has a cosign signature from one of these public keys (a file path or KMS URI) once it is in the Zarf registry, failing the deployment otherwise"
+	CmdPackageDeployFlagVariablesKey                   = "Key to encrypt sensitive variables with so they are stored with the deployed package and to decrypt the ones a previous deployment stored (sensitive variables are not stored without it)"
 	CmdPackageDeployValidateArchitectureErr            = "this package architecture is %s, but the target cluster only has the %s architecture(s). These architectures must be compatible when \"images\" are present"
 	CmdPackageDeployValidateLastNonBreakingVersionWarn = "The version of this Zarf binary '%s' is less than the LastNonBreakingVersion of '%s'. You may need to upgrade your Zarf version to at least '%s' to deploy this package"
 	CmdPackageDeployInvalidCLIVersionWarn              = "CLIVersion is set to '%s' which can cause issues with package creation and deployment. To avoid such issues, please set the value to the valid semantic version for this version of Zarf."
 	CmdPackageDeployErr                                = "Failed to deploy package: %s"
+	CmdPackageUpgradeErr                               = "Failed to upgrade package: %s"
 
 	CmdPackageMirrorFlagComponents = "Comma-separated list of components to mirror.  This list will be respected regardless of a component's 'required' or 'default' status.  Globbing component names with '*' and deselecting components with a leading '-' are also supported."
 	CmdPackageMirrorFlagNoChecksum = "Turns off the addition of a checksum to image tags (as would be used by the Zarf Agent) while mirroring images."
 
 	CmdPackageInspectFlagSbom    = "View SBOM contents while inspecting the package"
 	CmdPackageInspectFlagSbomOut = "Specify an output directory for the SBOMs from the inspected Zarf package"
+	CmdPackageInspectFlagVulns   = "View the vulnerabilities found in the package images and components when it was created"
 	CmdPackageInspectErr         = "Failed to inspect package: %s"
 
 	CmdPackageRemoveShort          = "Removes a Zarf package that has been deployed already (runs offline)"
 	CmdPackageRemoveFlagConfirm    = "REQUIRED. Confirm the removal action to prevent accidental deletions"
 	CmdPackageRemoveFlagComponents = "Comma-separated list of components to remove.  This list will be respected regardless of a component's 'required' or 'default' status.  Globbing component names with '*' and deselecting components with a leading '-' are also supported."
+	CmdPackageRemoveFlagPruneRepos = "Delete the repos of the removed components from the Zarf git server once no other deployed package references them"
 	CmdPackageRemoveTarballErr     = "Invalid tarball path provided"
 	CmdPackageRemoveExtractErr     = "Unable to extract the package contents"
 	CmdPackageRemoveErr            = "Unable to remove the package with an error of: %s"
 
+	CmdPackageVerifyShort = "Verifies that a deployed Zarf package still matches the cluster"
+	CmdPackageVerifyLong  = "Compares the resources of every Helm release of a deployed Zarf package with the live objects in the cluster,\n" +
+		"checks that its images are in the Zarf registry with the digests they were deployed with and that its mirrored\n" +
+		"git repos still point at the packaged refs. Exits with an error if anything has drifted."
+	CmdPackageVerifyErr = "Failed to verify package: %s"
+
+	CmdPackageVarsShort = "Views or edits the variables stored with a deployed Zarf package"
+	CmdPackageVarsLong  = "Prints the variables a Zarf package was last deployed with, which the next deployment of the package defaults to.\n" +
+		"Use --set and --unset to change the stored variables before the next deployment, sensitive variables are only\n" +
+		"shown, stored or changed with the --variables-key they were encrypted with."
+	CmdPackageVarsExample = `
+# View the variables of a deployed package
+$ zarf package vars my-package
+
+# Change and remove variables of a deployed package
+$ zarf package vars my-package --set REPLICAS=3 --unset LOG_LEVEL
+
+# View and change the sensitive variables of a deployed package as well
+$ zarf package vars my-package --set DB_PASSWORD=hunter2 --variables-key "$ZARF_VARIABLES_KEY"
+`
+	CmdPackageVarsFlagSet   = "Specify variables to store for the next deployment of the package (KEY=value)"
+	CmdPackageVarsFlagUnset = "Names of variables to remove from the package so the next deployment uses their defaults"
+	CmdPackageVarsErr       = "Failed to update the package variables: %s"
+
 	CmdPackageRegistryPrefixErr = "Registry must be prefixed with 'oci://'"
 
 	CmdPackagePublishShort   = "Publishes a Zarf package to a remote registry"
@@ -451,10 +532,29 @@ $ zarf tools registry digest 127.0.0.1:31999/stefanprodan/podinfo:6.4.0
 $ zarf tools registry digest reg.example.com/stefanprodan/podinfo:6.4.0
 `
 
-	CmdToolsRegistryPruneShort       = "Prunes images from the registry that are not currently being used by any Zarf packages."
+	CmdToolsRegistryPruneShort = "Prunes images from the registry that are not currently being used by any Zarf packages or pods."
+	CmdToolsRegistryPruneLong  = "Prunes images from the registry that are not currently being used by any Zarf packages or pods.\n\n" +
+		"Only the repositories that the images of deployed packages are pushed to are pruned. The SBOMs of deployed packages (in the zarf-sboms repository), " +
+		"images pushed with 'zarf package mirror-resources' and the images of packages that have been removed are never pruned, " +
+		"use 'zarf tools registry delete' to remove them."
 	CmdToolsRegistryPruneFlagConfirm = "Confirm the image prune action to prevent accidental deletions"
+	CmdToolsRegistryPruneFlagDryRun  = "List the images that would be pruned and the space that would be reclaimed without deleting anything"
 	CmdToolsRegistryPruneImageList   = "The following image digests will be pruned from the registry:"
 	CmdToolsRegistryPruneNoImages    = "There are no images to prune"
+	CmdToolsRegistryPruneReclaimable = "Pruning these images will reclaim %s of registry storage"
+	CmdToolsRegistryPruneDryRun      = "Dry run specified, no images were pruned"
+	CmdToolsRegistryPruneReclaimed   = "The pruned images will free %s once the registry garbage collects its unreferenced blobs (i.e. 'registry garbage-collect /etc/docker/registry/config.yml' in the registry pod)"
+
+	CmdToolsGitShort                = "Tools for working with the Zarf git server"
+	CmdToolsGitPruneShort           = "Prunes repos from the Zarf git server that are not currently being used by any Zarf packages"
+	CmdToolsGitPruneFlagConfirm     = "Confirm the repo prune action to prevent accidental deletions"
+	CmdToolsGitPruneFlagDryRun      = "List the repos that would be pruned without deleting or archiving anything"
+	CmdToolsGitPruneFlagArchive     = "Archive the unreferenced repos instead of deleting them"
+	CmdToolsGitPruneRepoList        = "The following repos will be deleted from the Zarf git server:"
+	CmdToolsGitPruneArchiveRepoList = "The following repos will be archived on the Zarf git server:"
+	CmdToolsGitPruneNoRepos         = "There are no repos to prune"
+	CmdToolsGitPruneDryRun          = "Dry run specified, no repos were pruned"
+	CmdToolsGitPruneErrExternal     = "repos can only be pruned from the internal Zarf git server"
 
 	CmdToolsRegistryInvalidPlatformErr = "Invalid platform '%s': %s"
 	CmdToolsRegistryFlagVerbose        = "Enable debug logs"
@@ -501,6 +601,24 @@ $ zarf tools registry digest reg.example.com/stefanprodan/podinfo:6.4.0
 	CmdToolsSbomShort = "Generates a Software Bill of Materials (SBOM) for the given package"
 	CmdToolsSbomErr   = "Unable to create SBOM (Syft) CLI"
 
+	CmdToolsSbomRescanShort = "Re-scans the SBOMs of every package deployed to the cluster against an offline vulnerability database"
+	CmdToolsSbomRescanLong  = "Re-scans the SBOMs that packages stored in the zarf registry when they were deployed against an offline Grype vulnerability database.\n" +
+		"This allows newly disclosed vulnerabilities to be found in the images and components running in an airgapped cluster by sneakernetting in a newer database."
+	CmdToolsSbomRescanExample = `
+# Re-scan all deployed packages against a Grype database archive:
+$ zarf tools sbom rescan --vuln-db vulnerability-db_v5.tar.gz
+
+# Fail if anything high or critical is found and save the full report:
+$ zarf tools sbom rescan --vuln-db vulnerability-db_v5.tar.gz --fail-on high --output report.json
+`
+	CmdToolsSbomRescanFlagVulnDB         = "Path to an offline Grype vulnerability database archive to scan the deployed package SBOMs against"
+	CmdToolsSbomRescanFlagFailOn         = "Exit with an error if the re-scan finds anything at or above this severity (negligible, low, medium, high, critical)"
+	CmdToolsSbomRescanFlagOutput         = "Write the findings of every deployed package to this file as JSON"
+	CmdToolsSbomRescanErr                = "Unable to re-scan the deployed packages: %s"
+	CmdToolsSbomRescanErrFailOn          = "Invalid --fail-on severity %q, valid options are: %s"
+	CmdToolsSbomRescanErrOutput          = "Unable to write the re-scan report to %s: %s"
+	CmdToolsSbomRescanErrVulnerabilities = "Found %d vulnerabilities at or above the %q severity in the deployed packages"
+
 	CmdToolsWaitForShort = "Waits for a given Kubernetes resource to be ready"
 	CmdToolsWaitForLong  = "By default Zarf will wait for all Kubernetes resources to be ready before completion of a component during a deployment.\n" +
 		"This command can be used to wait for a Kubernetes resources to exist and be ready that may be created by a Gitops tool or a Kubernetes operator.\n" +
@@ -606,6 +724,7 @@ const (
 	AgentErrCouldNotDeserializeReq = "could not deserialize request: %s"
 	AgentErrGetState               = "failed to load zarf state from file: %w"
 	AgentErrHostnameMatch          = "failed to complete hostname matching: %w"
+	AgentErrImageSignature         = "rejected the image %s as its signature could not be verified: %s"
 	AgentErrImageSwap              = "Unable to swap the host for (%s)"
 	AgentErrInvalidMethod          = "invalid method only POST requests are allowed"
 	AgentErrInvalidOp              = "invalid operation: %s"
@@ -618,9 +737,41 @@ const (
 	AgentErrUnableTransform        = "unable to transform the provided request; see zarf http proxy logs for more details"
 )
 
+// src/pkg/bundle
+const (
+	BundleErrKind                 = "the bundle kind %q is not supported, it must be %q"
+	BundleErrNoPackages           = "the bundle must have at least one package"
+	BundleErrPackageName          = "the bundle package with the source %q must have a name"
+	BundleErrPackageNameNotUnique = "the bundle package name %q is used more than once"
+	BundleErrPackageSource        = "the bundle package %q must have a source"
+	BundleErrDependsOn            = "the bundle package %q depends on %q which is not in the bundle"
+	BundleErrDependsOnCycle       = "unable to order the bundle packages with circular dependencies: %s"
+)
+
+// src/internal/packager/remove.
+const (
+	PkgRemoveWarnPruneExternalGitServer = "Repos can only be pruned from the internal Zarf git server, skipping the repo prune"
+)
+
+// src/internal/packager/verify
+const (
+	PkgVerifyErrNotDeployed = "package %q has not been deployed"
+	PkgVerifyErrDrift       = "%d resources, images or repos of package %q have drifted from what was deployed"
+)
+
+// src/internal/packager/vars
+const (
+	PkgVarsErrSensitiveNoKey = "variable %q is sensitive and can only be set with a --variables-key to encrypt it with"
+	PkgVarsErrSourced        = "variable %q is resolved from its source on each deployment and is not stored with the package"
+)
+
 // src/internal/packager/create
 const (
 	PkgCreateErrDifferentialSameVersion = "unable to create a differential package with the same version as the package you are using as a reference; the package version must be incremented"
+	PkgCreateErrFailOnSeverity          = "invalid --fail-on severity %q, valid options are: %s"
+	PkgCreateErrFailOnWithoutVulnDB     = "the --fail-on flag requires a vulnerability database to scan with from --vuln-db"
+	PkgCreateErrVulnDBSkipSBOM          = "the --vuln-db flag scans the package SBOMs and cannot be used with --skip-sbom"
+	PkgCreateErrVulnerabilities         = "found %d vulnerabilities at or above the %q severity"
 )
 
 // src/internal/packager/deploy.
@@ -629,6 +780,15 @@ const (
 	PkgDeployErrNoDefaultOrSelection               = "You must make a selection from %q with the --components flag as there is no default in their group."
 	PkgDeployErrNoCompatibleComponentsForSelection = "No compatible components found that matched %q. Please check spelling and try again."
 	PkgDeployErrComponentSelectionCanceled         = "Component selection canceled: %s"
+	PkgDeployErrPlanInitPackage                    = "the --plan flag is not supported for init packages"
+	PkgDeployErrResumeInitPackage                  = "the --resume flag is not supported for init packages"
+	PkgDeployErrVariableSource                     = "unable to resolve variable %q from %s: %w"
+	PkgDeployErrVariableType                       = "provided value for variable %q is not a valid %s: %w"
+	PkgDeployErrChartVariable                      = "unable to set value %q of chart %q from variable %q: %w"
+	PkgDeployWarnVariableNoKey                     = "Variable %q was stored encrypted by a previous deployment, provide the --variables-key it was encrypted with to default to its previous value"
+	PkgDeployWarnVariableDecrypt                   = "Unable to decrypt the previous value of variable %q: %s"
+	PkgUpgradeErrInitPackage                       = "init packages cannot be upgraded, use 'zarf init' instead"
+	PkgUpgradeErrNotDeployed                       = "package %q has not been deployed, use 'zarf package deploy' instead"
 )
 
 // src/internal/packager/validate.
@@ -646,9 +806,14 @@ const (
 	PkgValidateErrChartNamespaceMissing   = "chart %q must include a namespace"
 	PkgValidateErrChartURLOrPath          = "chart %q must have either a url or localPath"
 	PkgValidateErrChartVersion            = "chart %q must include a chart version"
+	PkgValidateErrChartVariable           = "chart %q variable %q must be all uppercase and include a path"
 	PkgValidateErrComponentName           = "component name %q must be all lowercase and contain no special characters except '-' and cannot start with a '-'"
 	PkgValidateErrComponentNameNotUnique  = "component name %q is not unique"
+	PkgValidateErrComponentDependsOn      = "component %q cannot depend on %q as it is not a component in this package"
+	PkgValidateErrComponentDependsOnSelf  = "component %q cannot depend on itself"
+	PkgValidateErrComponentDependsOnCycle = "component %q has a circular dependency through %q"
 	PkgValidateErrComponent               = "invalid component %q: %w"
+	PkgValidateErrFileTemplate            = "file %q has an unsupported template %q, the only supported template is %q"
 	PkgValidateErrComponentReqDefault     = "component %q cannot be both required and default"
 	PkgValidateErrComponentReqGrouped     = "component %q cannot be both required and grouped"
 	PkgValidateErrComponentYOLO           = "component %q incompatible with the online-only package flag (metadata.yolo): %w"
@@ -662,11 +827,14 @@ const (
 	PkgValidateErrManifestNameLength      = "manifest %q exceed the maximum length of %d characters"
 	PkgValidateErrManifestNameMissing     = "manifest %q must include a name"
 	PkgValidateErrManifestNameNotUnique   = "manifest name %q is not unique"
+	PkgValidateErrManifestTemplate        = "manifest %q has an unsupported template %q, the only supported template is %q"
 	PkgValidateErrName                    = "invalid package name: %w"
 	PkgValidateErrPkgConstantName         = "constant name %q must be all uppercase and contain no special characters except _"
 	PkgValidateErrPkgConstantPattern      = "provided value for constant %q does not match pattern %q"
 	PkgValidateErrPkgName                 = "package name %q must be all lowercase and contain no special characters except '-' and cannot start with a '-'"
 	PkgValidateErrVariable                = "invalid package variable: %w"
+	PkgValidateErrVariableSource          = "variable %q has an invalid source: %w"
+	PkgValidateErrVariableType            = "variable %q has an invalid type definition: %w"
 	PkgValidateErrYOLONoArch              = "cluster architecture not allowed"
 	PkgValidateErrYOLONoDistro            = "cluster distros not allowed"
 	PkgValidateErrYOLONoGit               = "git repos not allowed"
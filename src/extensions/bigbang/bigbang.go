@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/Masterminds/semver/v3"
+	"github.com/defenseunicorns/zarf/src/internal/packager/flux"
 	"github.com/defenseunicorns/zarf/src/internal/packager/helm"
 	"github.com/defenseunicorns/zarf/src/pkg/layout"
 	"github.com/defenseunicorns/zarf/src/pkg/message"
@@ -21,9 +22,7 @@ import (
 	"github.com/defenseunicorns/zarf/src/types"
 	"github.com/defenseunicorns/zarf/src/types/extensions"
 	fluxHelmCtrl "github.com/fluxcd/helm-controller/api/v2beta1"
-	fluxSrcCtrl "github.com/fluxcd/source-controller/api/v1beta2"
 	"helm.sh/helm/v3/pkg/chartutil"
-	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/yaml"
 )
@@ -214,7 +213,7 @@ func Run(YOLO bool, tmpPaths *layout.ComponentPaths, c types.ZarfComponent) (typ
 	// Select the images needed to support the repos for this configuration of Big Bang.
 	if !YOLO {
 		for _, hr := range hrDependencies {
-			namespacedName := getNamespacedNameFromMeta(hr.Metadata)
+			namespacedName := flux.GetNamespacedNameFromMeta(hr.Metadata)
 			gitRepo := gitRepos[hr.NamespacedSource]
 			values := hrValues[namespacedName]
 
@@ -344,109 +343,30 @@ func isValidVersion(version string) (bool, error) {
 // findBBResources takes a list of yaml objects (as a string) and
 // parses it for GitRepository objects that it then parses
 // to return the list of git repos and tags needed.
-func findBBResources(t string) (gitRepos map[string]string, helmReleaseDeps map[string]HelmReleaseDependency, helmReleaseValues map[string]map[string]interface{}, err error) {
+func findBBResources(t string) (gitRepos map[string]string, helmReleaseDeps map[string]flux.HelmReleaseDependency, helmReleaseValues map[string]map[string]interface{}, err error) {
 	// Break the template into separate resources.
-	yamls, _ := utils.SplitYAMLToString([]byte(t))
+	yamls, _ := utils.SplitYAML([]byte(t))
 
-	gitRepos = map[string]string{}
-	helmReleaseDeps = map[string]HelmReleaseDependency{}
-	helmReleaseValues = map[string]map[string]interface{}{}
-	secrets := map[string]corev1.Secret{}
-	configMaps := map[string]corev1.ConfigMap{}
-
-	for _, y := range yamls {
-		var (
-			h fluxHelmCtrl.HelmRelease
-			g fluxSrcCtrl.GitRepository
-			s corev1.Secret
-			c corev1.ConfigMap
-		)
-
-		if err := yaml.Unmarshal([]byte(y), &h); err != nil {
-			continue
-		}
-
-		// If the resource is a HelmRelease, parse it for the dependencies.
-		if h.Kind == fluxHelmCtrl.HelmReleaseKind {
-			var deps []string
-			for _, d := range h.Spec.DependsOn {
-				depNamespacedName := getNamespacedNameFromStr(d.Namespace, d.Name)
-				deps = append(deps, depNamespacedName)
-			}
-
-			namespacedName := getNamespacedNameFromMeta(h.ObjectMeta)
-			srcNamespacedName := getNamespacedNameFromStr(h.Spec.Chart.Spec.SourceRef.Namespace,
-				h.Spec.Chart.Spec.SourceRef.Name)
-
-			helmReleaseDeps[namespacedName] = HelmReleaseDependency{
-				Metadata:               h.ObjectMeta,
-				NamespacedDependencies: deps,
-				NamespacedSource:       srcNamespacedName,
-				ValuesFrom:             h.Spec.ValuesFrom,
-			}
-
-			// Skip the rest as this is not a GitRepository.
-			continue
-		}
-
-		if err := yaml.Unmarshal([]byte(y), &g); err != nil {
-			continue
-		}
-
-		// If the resource is a GitRepository, parse it for the URL and tag.
-		if g.Kind == fluxSrcCtrl.GitRepositoryKind && g.Spec.URL != "" {
-			ref := "master"
-
-			switch {
-			case g.Spec.Reference.Commit != "":
-				ref = g.Spec.Reference.Commit
-
-			case g.Spec.Reference.SemVer != "":
-				ref = g.Spec.Reference.SemVer
-
-			case g.Spec.Reference.Tag != "":
-				ref = g.Spec.Reference.Tag
-
-			case g.Spec.Reference.Branch != "":
-				ref = g.Spec.Reference.Branch
-			}
-
-			// Set the URL and tag in the repo map
-			namespacedName := getNamespacedNameFromMeta(g.ObjectMeta)
-			gitRepos[namespacedName] = fmt.Sprintf("%s@%s", g.Spec.URL, ref)
-		}
-
-		if err := yaml.Unmarshal([]byte(y), &s); err != nil {
-			continue
-		}
-
-		// If the resource is a Secret, parse it so it can be used later for value templating.
-		if s.Kind == "Secret" {
-			namespacedName := getNamespacedNameFromMeta(s.ObjectMeta)
-			secrets[namespacedName] = s
-		}
-
-		if err := yaml.Unmarshal([]byte(y), &c); err != nil {
-			continue
-		}
+	resources, err := flux.FindResources(yamls)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
-		// If the resource is a Secret, parse it so it can be used later for value templating.
-		if c.Kind == "ConfigMap" {
-			namespacedName := getNamespacedNameFromMeta(c.ObjectMeta)
-			configMaps[namespacedName] = c
-		}
+	gitRepos = map[string]string{}
+	for namespacedName, gitRepo := range resources.GitRepositories {
+		gitRepos[namespacedName] = gitRepo.Repo()
 	}
 
-	for _, hr := range helmReleaseDeps {
-		namespacedName := getNamespacedNameFromMeta(hr.Metadata)
-		values, err := composeValues(hr, secrets, configMaps)
+	helmReleaseValues = map[string]map[string]interface{}{}
+	for namespacedName, hr := range resources.HelmReleases {
+		values, err := resources.ComposeValues(hr)
 		if err != nil {
 			return nil, nil, nil, err
 		}
 		helmReleaseValues[namespacedName] = values
 	}
 
-	return gitRepos, helmReleaseDeps, helmReleaseValues, nil
+	return gitRepos, resources.HelmReleases, helmReleaseValues, nil
 }
 
 // addBigBangManifests creates the manifests component for deploying Big Bang.
@@ -0,0 +1,46 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package bigbang contains the logic for installing Big Bang and Flux
+package bigbang
+
+import (
+	"github.com/defenseunicorns/zarf/src/pkg/layout"
+	"github.com/defenseunicorns/zarf/src/types"
+)
+
+// Extension is the Big Bang component extension configured under extensions.bigbang.
+type Extension struct{}
+
+// Configured returns true if the component configures Big Bang.
+func (Extension) Configured(c types.ZarfComponent) bool {
+	return c.Extensions.BigBang != nil
+}
+
+// Run mutates a component that should deploy Big Bang to the flux deployment of Big Bang.
+func (Extension) Run(YOLO bool, componentPaths *layout.ComponentPaths, c types.ZarfComponent) (types.ZarfComponent, error) {
+	return Run(YOLO, componentPaths, c)
+}
+
+// Skeletonize mutates a component so that the Big Bang values and flux patch files can be contained inside a skeleton package.
+func (Extension) Skeletonize(componentPaths *layout.ComponentPaths, c types.ZarfComponent) (types.ZarfComponent, error) {
+	return Skeletonize(componentPaths, c)
+}
+
+// Compose merges the Big Bang config of an imported component into a component.
+func (Extension) Compose(c *types.ZarfComponent, override types.ZarfComponent, relativeTo string) {
+	Compose(c, override, relativeTo)
+}
+
+// FindImages returns the images of flux and the Big Bang packages enabled by the values files.
+func (Extension) FindImages(componentPaths *layout.ComponentPaths, c types.ZarfComponent) ([]string, error) {
+	// Only return the images Big Bang adds
+	c.Images = nil
+
+	c, err := Run(false, componentPaths, c)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.Images, nil
+}
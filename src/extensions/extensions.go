@@ -0,0 +1,123 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package extensions contains the registry of the component extensions the packager runs.
+package extensions
+
+import (
+	"fmt"
+
+	"github.com/defenseunicorns/zarf/src/extensions/bigbang"
+	"github.com/defenseunicorns/zarf/src/pkg/layout"
+	"github.com/defenseunicorns/zarf/src/types"
+)
+
+// Extension is a component extension that mutates the components that configure it as packages are composed, created and published.
+// Each extension's config is a typed field of types/extensions.ZarfComponentExtensions carrying its own schema.
+type Extension interface {
+	// Configured returns true if the component configures this extension.
+	Configured(c types.ZarfComponent) bool
+	// Run mutates a component on create into the charts, manifests, images and repos the extension deploys.
+	Run(YOLO bool, componentPaths *layout.ComponentPaths, c types.ZarfComponent) (types.ZarfComponent, error)
+	// Skeletonize mutates a component so that the local files of its config can be contained inside a skeleton package.
+	Skeletonize(componentPaths *layout.ComponentPaths, c types.ZarfComponent) (types.ZarfComponent, error)
+	// Compose merges the config of an imported component (the override) into a component with its local paths made relative to relativeTo.
+	Compose(c *types.ZarfComponent, override types.ZarfComponent, relativeTo string)
+	// FindImages returns the images the extension would add to a component for 'zarf dev find-images'.
+	FindImages(componentPaths *layout.ComponentPaths, c types.ZarfComponent) ([]string, error)
+}
+
+type registeredExtension struct {
+	name      string
+	extension Extension
+}
+
+// registry holds the extensions in the order they are run.
+var registry = []registeredExtension{
+	{name: "bigbang", extension: bigbang.Extension{}},
+}
+
+// Register adds an extension to the registry under the name its config is keyed by in a component's extensions.
+// Extensions run in the order they are registered.
+func Register(name string, extension Extension) error {
+	for _, registered := range registry {
+		if registered.name == name {
+			return fmt.Errorf("an extension named %q is already registered", name)
+		}
+	}
+
+	registry = append(registry, registeredExtension{name: name, extension: extension})
+
+	return nil
+}
+
+// Run runs the extensions a component configures on create.
+func Run(YOLO bool, componentPaths *layout.ComponentPaths, c types.ZarfComponent) (types.ZarfComponent, error) {
+	for _, registered := range registry {
+		if !registered.extension.Configured(c) {
+			continue
+		}
+
+		var err error
+		if c, err = registered.extension.Run(YOLO, componentPaths, c); err != nil {
+			return c, fmt.Errorf("unable to process %s extension: %w", registered.name, err)
+		}
+	}
+
+	return c, nil
+}
+
+// Skeletonize runs the extensions a component configures on skeleton publish.
+func Skeletonize(componentPaths *layout.ComponentPaths, c types.ZarfComponent) (types.ZarfComponent, error) {
+	for _, registered := range registry {
+		if !registered.extension.Configured(c) {
+			continue
+		}
+
+		var err error
+		if c, err = registered.extension.Skeletonize(componentPaths, c); err != nil {
+			return c, fmt.Errorf("unable to process %s extension: %w", registered.name, err)
+		}
+	}
+
+	return c, nil
+}
+
+// Compose merges the extension configs of an imported component into a component.
+func Compose(c *types.ZarfComponent, override types.ZarfComponent, relativeTo string) {
+	for _, registered := range registry {
+		if registered.extension.Configured(override) {
+			registered.extension.Compose(c, override, relativeTo)
+		}
+	}
+}
+
+// FindImages returns the images the extensions a component configures would add to it.
+func FindImages(componentPaths *layout.ComponentPaths, c types.ZarfComponent) ([]string, error) {
+	images := []string{}
+
+	for _, registered := range registry {
+		if !registered.extension.Configured(c) {
+			continue
+		}
+
+		extensionImages, err := registered.extension.FindImages(componentPaths, c)
+		if err != nil {
+			return nil, fmt.Errorf("unable to find images for %s extension: %w", registered.name, err)
+		}
+		images = append(images, extensionImages...)
+	}
+
+	return images, nil
+}
+
+// Configured returns true if a component configures any registered extension.
+func Configured(c types.ZarfComponent) bool {
+	for _, registered := range registry {
+		if registered.extension.Configured(c) {
+			return true
+		}
+	}
+
+	return false
+}
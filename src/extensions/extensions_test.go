@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package extensions contains the registry of the component extensions the packager runs.
+package extensions
+
+import (
+	"testing"
+
+	"github.com/defenseunicorns/zarf/src/pkg/layout"
+	"github.com/defenseunicorns/zarf/src/types"
+	"github.com/stretchr/testify/require"
+)
+
+// testExtension is configured on components with its name and adds an image to them.
+type testExtension struct {
+	name string
+}
+
+func (e testExtension) Configured(c types.ZarfComponent) bool {
+	return c.Name == e.name
+}
+
+func (e testExtension) Run(_ bool, _ *layout.ComponentPaths, c types.ZarfComponent) (types.ZarfComponent, error) {
+	c.Images = append(c.Images, e.name+":run")
+	return c, nil
+}
+
+func (e testExtension) Skeletonize(_ *layout.ComponentPaths, c types.ZarfComponent) (types.ZarfComponent, error) {
+	return c, nil
+}
+
+func (e testExtension) Compose(c *types.ZarfComponent, _ types.ZarfComponent, _ string) {
+	c.Images = append(c.Images, e.name+":compose")
+}
+
+func (e testExtension) FindImages(_ *layout.ComponentPaths, _ types.ZarfComponent) ([]string, error) {
+	return []string{e.name + ":find"}, nil
+}
+
+func TestRegistry(t *testing.T) {
+	original := registry
+	t.Cleanup(func() {
+		registry = original
+	})
+
+	require.NoError(t, Register("test", testExtension{name: "test"}))
+	require.Error(t, Register("test", testExtension{name: "other"}))
+
+	unconfigured := types.ZarfComponent{Name: "unconfigured"}
+	require.False(t, Configured(unconfigured))
+	c, err := Run(false, nil, unconfigured)
+	require.NoError(t, err)
+	require.Empty(t, c.Images)
+
+	configured := types.ZarfComponent{Name: "test"}
+	require.True(t, Configured(configured))
+	c, err = Run(false, nil, configured)
+	require.NoError(t, err)
+	require.Equal(t, []string{"test:run"}, c.Images)
+
+	images, err := FindImages(nil, configured)
+	require.NoError(t, err)
+	require.Equal(t, []string{"test:find"}, images)
+
+	// Compose only runs for the extensions the imported component configures
+	Compose(&unconfigured, configured, "")
+	require.Equal(t, []string{"test:compose"}, unconfigured.Images)
+	Compose(&configured, types.ZarfComponent{Name: "unconfigured"}, "")
+	require.Empty(t, configured.Images)
+}
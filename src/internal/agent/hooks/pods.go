@@ -5,8 +5,11 @@
 package hooks
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/defenseunicorns/zarf/src/config"
 	"github.com/defenseunicorns/zarf/src/config/lang"
@@ -14,11 +17,20 @@ import (
 	"github.com/defenseunicorns/zarf/src/internal/agent/state"
 	"github.com/defenseunicorns/zarf/src/pkg/message"
 	"github.com/defenseunicorns/zarf/src/pkg/transform"
+	"github.com/defenseunicorns/zarf/src/pkg/utils"
+	"github.com/defenseunicorns/zarf/src/types"
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"golang.org/x/sync/errgroup"
 	v1 "k8s.io/api/admission/v1"
 
 	corev1 "k8s.io/api/core/v1"
 )
 
+// imageVerificationTimeout is kept under the 10 second default timeout of the webhook.
+const imageVerificationTimeout = 8 * time.Second
+
 // NewPodMutationHook creates a new instance of pods mutation hook.
 func NewPodMutationHook() operations.Hook {
 	message.Debug("hooks.NewMutationHook()")
@@ -65,6 +77,9 @@ func mutatePod(r *v1.AdmissionRequest) (*operations.Result, error) {
 	}
 	containerRegistryURL := zarfState.RegistryInfo.Address
 
+	// Track the images the pod will pull from the Zarf registry so their signatures can be verified
+	var replacements []string
+
 	// update the image host for each init container
 	for idx, container := range pod.Spec.InitContainers {
 		path := fmt.Sprintf("/spec/initContainers/%d/image", idx)
@@ -74,6 +89,7 @@ func mutatePod(r *v1.AdmissionRequest) (*operations.Result, error) {
 			continue // Continue, because we might as well attempt to mutate the other containers for this pod
 		}
 		patchOperations = append(patchOperations, operations.ReplacePatchOperation(path, replacement))
+		replacements = append(replacements, replacement)
 	}
 
 	// update the image host for each ephemeral container
@@ -85,6 +101,7 @@ func mutatePod(r *v1.AdmissionRequest) (*operations.Result, error) {
 			continue // Continue, because we might as well attempt to mutate the other containers for this pod
 		}
 		patchOperations = append(patchOperations, operations.ReplacePatchOperation(path, replacement))
+		replacements = append(replacements, replacement)
 	}
 
 	// update the image host for each normal container
@@ -96,6 +113,15 @@ func mutatePod(r *v1.AdmissionRequest) (*operations.Result, error) {
 			continue // Continue, because we might as well attempt to mutate the other containers for this pod
 		}
 		patchOperations = append(patchOperations, operations.ReplacePatchOperation(path, replacement))
+		replacements = append(replacements, replacement)
+	}
+
+	// Reject the pod if any of its images is not signed by one of the keys the agent was configured with
+	if len(zarfState.ImageVerification.PublicKeys) > 0 {
+		if err := verifyImageSignatures(zarfState, replacements); err != nil {
+			message.Warn(err.Error())
+			return &operations.Result{Msg: err.Error()}, nil
+		}
 	}
 
 	// Add a label noting the zarf mutation
@@ -106,3 +132,47 @@ func mutatePod(r *v1.AdmissionRequest) (*operations.Result, error) {
 		PatchOps: patchOperations,
 	}, nil
 }
+
+// verifyImageSignatures verifies the signatures of the images of a pod in parallel, returning the first image that could not be verified.
+// The verification is bounded by imageVerificationTimeout so a slow registry rejects the pod rather than timing out the admission request.
+func verifyImageSignatures(zarfState *types.ZarfState, images []string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), imageVerificationTimeout)
+	defer cancel()
+
+	eg, ectx := errgroup.WithContext(ctx)
+	for _, image := range images {
+		image := image
+		eg.Go(func() error {
+			if err := verifyImageSignature(ectx, zarfState, image); err != nil {
+				return fmt.Errorf(lang.AgentErrImageSignature, image, err.Error())
+			}
+			return nil
+		})
+	}
+
+	return eg.Wait()
+}
+
+// verifyImageSignature verifies that an image in the Zarf registry has a cosign signature made by one of the keys in the Zarf state.
+func verifyImageSignature(ctx context.Context, zarfState *types.ZarfState, image string) error {
+	registryInfo := zarfState.RegistryInfo
+	var nameOptions []name.Option
+
+	// The node port of the internal registry is only reachable from the nodes so go through its service instead
+	if registryInfo.InternalRegistry {
+		image = strings.Replace(image, registryInfo.Address, config.ZarfInClusterContainerRegistryHost, 1)
+		nameOptions = append(nameOptions, name.Insecure)
+	}
+
+	ref, err := name.ParseReference(image, nameOptions...)
+	if err != nil {
+		return err
+	}
+
+	auth := remote.WithAuth(&authn.Basic{
+		Username: registryInfo.PullUsername,
+		Password: registryInfo.PullPassword,
+	})
+
+	return utils.CosignVerifyImageSignaturesPEM(ctx, ref, zarfState.ImageVerification.PublicKeys, auth, remote.WithContext(ctx))
+}
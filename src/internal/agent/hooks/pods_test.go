@@ -0,0 +1,130 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package hooks provides HTTP handlers for the mutating webhook.
+package hooks
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"log"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/defenseunicorns/zarf/src/types"
+	"github.com/google/go-containerregistry/pkg/crane"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/sigstore/cosign/v2/pkg/oci/mutate"
+	ociremote "github.com/sigstore/cosign/v2/pkg/oci/remote"
+	"github.com/sigstore/cosign/v2/pkg/oci/static"
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
+	"github.com/sigstore/sigstore/pkg/signature"
+	"github.com/sigstore/sigstore/pkg/signature/payload"
+	"github.com/stretchr/testify/require"
+)
+
+// newSigningKey returns a cosign signer and its PEM encoded public key.
+func newSigningKey(t *testing.T) (signature.SignerVerifier, string) {
+	t.Helper()
+
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	signer, err := signature.LoadECDSASignerVerifier(priv, crypto.SHA256)
+	require.NoError(t, err)
+	pub, err := cryptoutils.MarshalPublicKeyToPEM(priv.Public())
+	require.NoError(t, err)
+
+	return signer, string(pub)
+}
+
+// pushTestImage pushes a random image to a registry and signs it with the signer when one is given.
+func pushTestImage(t *testing.T, image string, signer signature.Signer) {
+	t.Helper()
+
+	img, err := random.Image(256, 1)
+	require.NoError(t, err)
+	require.NoError(t, crane.Push(img, image))
+
+	if signer == nil {
+		return
+	}
+
+	ref, err := name.ParseReference(image)
+	require.NoError(t, err)
+	digest, err := img.Digest()
+	require.NoError(t, err)
+	digestRef := ref.Context().Digest(digest.String())
+
+	sigPayload, err := (&payload.Cosign{Image: digestRef}).MarshalJSON()
+	require.NoError(t, err)
+	sig, err := signer.SignMessage(bytes.NewReader(sigPayload))
+	require.NoError(t, err)
+	ociSig, err := static.NewSignature(sigPayload, base64.StdEncoding.EncodeToString(sig))
+	require.NoError(t, err)
+
+	se, err := ociremote.SignedEntity(digestRef)
+	require.NoError(t, err)
+	se, err = mutate.AttachSignatureToEntity(se, ociSig)
+	require.NoError(t, err)
+	require.NoError(t, ociremote.WriteSignatures(digestRef.Repository, se))
+}
+
+// TestVerifyImageSignatures verifies that a pod is only admitted when all of its images are signed by a key in the Zarf state.
+func TestVerifyImageSignatures(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(registry.New(registry.Logger(log.New(io.Discard, "", 0))))
+	t.Cleanup(server.Close)
+	registryURL := strings.TrimPrefix(server.URL, "http://")
+
+	trustedSigner, trustedKey := newSigningKey(t)
+	untrustedSigner, _ := newSigningKey(t)
+
+	signed := fmt.Sprintf("%s/stefanprodan/podinfo:6.4.0-zarf-2823281104", registryURL)
+	pushTestImage(t, signed, trustedSigner)
+	untrusted := fmt.Sprintf("%s/library/nginx:1.25-zarf-3793515731", registryURL)
+	pushTestImage(t, untrusted, untrustedSigner)
+	unsigned := fmt.Sprintf("%s/library/busybox:1.36-zarf-1825447916", registryURL)
+	pushTestImage(t, unsigned, nil)
+
+	type testCase struct {
+		name   string
+		images []string
+		allow  bool
+	}
+
+	testCases := []testCase{
+		{name: "signed by a trusted key", images: []string{signed}, allow: true},
+		{name: "signed by an untrusted key", images: []string{untrusted}, allow: false},
+		{name: "unsigned", images: []string{unsigned}, allow: false},
+		{name: "one of several images unsigned", images: []string{signed, unsigned}, allow: false},
+	}
+
+	zarfState := &types.ZarfState{
+		RegistryInfo:      types.RegistryInfo{Address: registryURL},
+		ImageVerification: types.ImageVerificationInfo{PublicKeys: []string{trustedKey}},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := verifyImageSignatures(zarfState, tc.images)
+			if tc.allow {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,305 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package flux contains functions for resolving the charts and kustomizations Flux deploys from its source objects.
+package flux
+
+import (
+	"fmt"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/defenseunicorns/zarf/src/pkg/utils/helpers"
+	fluxHelmCtrl "github.com/fluxcd/helm-controller/api/v2beta1"
+	fluxSrcCtrl "github.com/fluxcd/source-controller/api/v1beta2"
+	"helm.sh/helm/v3/pkg/chartutil"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	helmGroup      = "helm.toolkit.fluxcd.io"
+	kustomizeGroup = "kustomize.toolkit.fluxcd.io"
+	sourceGroup    = "source.toolkit.fluxcd.io"
+
+	// KustomizationKind is the kind of a Flux Kustomization.
+	KustomizationKind = "Kustomization"
+)
+
+// GitRepository is a Flux GitRepository source resolved to the URL and ref it checks out.
+type GitRepository struct {
+	URL string
+	Ref string
+	// SemVer is the range of tags to check out the latest of, the ref is empty until it is resolved with ResolveSemVer
+	SemVer string
+}
+
+// ResolveSemVer sets the ref of a GitRepository with a semver range to the latest of the given tags in the range, as Flux would.
+func (g *GitRepository) ResolveSemVer(tags []string) error {
+	constraint, err := semver.NewConstraint(g.SemVer)
+	if err != nil {
+		return fmt.Errorf("invalid semver range %q: %w", g.SemVer, err)
+	}
+
+	var latest *semver.Version
+	for _, tag := range tags {
+		version, err := semver.NewVersion(tag)
+		if err != nil {
+			continue
+		}
+		if constraint.Check(version) && (latest == nil || version.GreaterThan(latest)) {
+			latest = version
+			g.Ref = tag
+		}
+	}
+
+	if latest == nil {
+		return fmt.Errorf("no tag of %s is in the semver range %q", g.URL, g.SemVer)
+	}
+
+	return nil
+}
+
+// Repo returns the repo in the url@ref form Zarf uses for git repos.
+func (g GitRepository) Repo() string {
+	return fmt.Sprintf("%s@%s", g.URL, g.Ref)
+}
+
+// HelmReleaseDependency is a struct that represents a Flux Helm Release from an HR DependsOn list.
+type HelmReleaseDependency struct {
+	Metadata               metav1.ObjectMeta
+	NamespacedDependencies []string
+	NamespacedSource       string
+	SourceKind             string
+	Chart                  string
+	Version                string
+	Values                 map[string]interface{}
+	ValuesFrom             []fluxHelmCtrl.ValuesReference
+}
+
+// Name returns a namespaced name for the HelmRelease for dependency sorting.
+func (h HelmReleaseDependency) Name() string {
+	return GetNamespacedNameFromMeta(h.Metadata)
+}
+
+// Dependencies returns a list of namespaced dependencies for the HelmRelease for dependency sorting.
+func (h HelmReleaseDependency) Dependencies() []string {
+	return h.NamespacedDependencies
+}
+
+// Kustomization is a Flux Kustomization that builds a path within a source.
+type Kustomization struct {
+	Metadata         metav1.ObjectMeta
+	NamespacedSource string
+	SourceKind       string
+	Path             string
+}
+
+// Resources are the Flux sources, HelmReleases and Kustomizations found in a set of K8s resources
+// along with the Secrets and ConfigMaps their values may come from, each keyed by its namespaced name.
+type Resources struct {
+	GitRepositories  map[string]GitRepository
+	HelmRepositories map[string]string
+	HelmReleases     map[string]HelmReleaseDependency
+	Kustomizations   map[string]Kustomization
+	Secrets          map[string]corev1.Secret
+	ConfigMaps       map[string]corev1.ConfigMap
+}
+
+// FindResources parses the Flux objects and the Secrets and ConfigMaps out of a list of K8s resources.
+func FindResources(resources []*unstructured.Unstructured) (Resources, error) {
+	found := Resources{
+		GitRepositories:  map[string]GitRepository{},
+		HelmRepositories: map[string]string{},
+		HelmReleases:     map[string]HelmReleaseDependency{},
+		Kustomizations:   map[string]Kustomization{},
+		Secrets:          map[string]corev1.Secret{},
+		ConfigMaps:       map[string]corev1.ConfigMap{},
+	}
+
+	for _, resource := range resources {
+		gvk := resource.GroupVersionKind()
+		content := resource.UnstructuredContent()
+		namespacedName := GetNamespacedNameFromStr(resource.GetNamespace(), resource.GetName())
+
+		switch {
+		case gvk.Group == helmGroup && gvk.Kind == fluxHelmCtrl.HelmReleaseKind:
+			var h fluxHelmCtrl.HelmRelease
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(content, &h); err != nil {
+				return found, fmt.Errorf("could not parse HelmRelease '%s': %w", namespacedName, err)
+			}
+
+			var deps []string
+			for _, d := range h.Spec.DependsOn {
+				deps = append(deps, GetNamespacedNameFromStr(defaultNamespace(d.Namespace, h.Namespace), d.Name))
+			}
+
+			sourceRef := h.Spec.Chart.Spec.SourceRef
+			found.HelmReleases[namespacedName] = HelmReleaseDependency{
+				Metadata:               h.ObjectMeta,
+				NamespacedDependencies: deps,
+				NamespacedSource:       GetNamespacedNameFromStr(defaultNamespace(sourceRef.Namespace, h.Namespace), sourceRef.Name),
+				SourceKind:             sourceRef.Kind,
+				Chart:                  h.Spec.Chart.Spec.Chart,
+				Version:                h.Spec.Chart.Spec.Version,
+				Values:                 h.GetValues(),
+				ValuesFrom:             h.Spec.ValuesFrom,
+			}
+
+		case gvk.Group == kustomizeGroup && gvk.Kind == KustomizationKind:
+			// The kustomize-controller API is not a dependency so only the fields needed to find the source are read
+			sourceKind, _, _ := unstructured.NestedString(content, "spec", "sourceRef", "kind")
+			sourceName, _, _ := unstructured.NestedString(content, "spec", "sourceRef", "name")
+			sourceNamespace, _, _ := unstructured.NestedString(content, "spec", "sourceRef", "namespace")
+			path, _, _ := unstructured.NestedString(content, "spec", "path")
+
+			found.Kustomizations[namespacedName] = Kustomization{
+				Metadata: metav1.ObjectMeta{
+					Name:      resource.GetName(),
+					Namespace: resource.GetNamespace(),
+				},
+				NamespacedSource: GetNamespacedNameFromStr(defaultNamespace(sourceNamespace, resource.GetNamespace()), sourceName),
+				SourceKind:       sourceKind,
+				Path:             path,
+			}
+
+		case gvk.Group == sourceGroup && gvk.Kind == fluxSrcCtrl.GitRepositoryKind:
+			var g fluxSrcCtrl.GitRepository
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(content, &g); err != nil {
+				return found, fmt.Errorf("could not parse GitRepository '%s': %w", namespacedName, err)
+			}
+
+			if g.Spec.URL == "" {
+				continue
+			}
+
+			// Branches are written as full refs since Zarf assumes a plain ref is a tag
+			gitRepo := GitRepository{URL: g.Spec.URL, Ref: "refs/heads/master"}
+			if g.Spec.Reference != nil {
+				switch {
+				case g.Spec.Reference.Commit != "":
+					gitRepo.Ref = g.Spec.Reference.Commit
+
+				case g.Spec.Reference.SemVer != "":
+					gitRepo.Ref = ""
+					gitRepo.SemVer = g.Spec.Reference.SemVer
+
+				case g.Spec.Reference.Tag != "":
+					gitRepo.Ref = g.Spec.Reference.Tag
+
+				case g.Spec.Reference.Branch != "":
+					gitRepo.Ref = "refs/heads/" + g.Spec.Reference.Branch
+				}
+			}
+
+			found.GitRepositories[namespacedName] = gitRepo
+
+		case gvk.Group == sourceGroup && gvk.Kind == fluxSrcCtrl.HelmRepositoryKind:
+			var r fluxSrcCtrl.HelmRepository
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(content, &r); err != nil {
+				return found, fmt.Errorf("could not parse HelmRepository '%s': %w", namespacedName, err)
+			}
+
+			found.HelmRepositories[namespacedName] = r.Spec.URL
+
+		case gvk.Group == "" && gvk.Kind == "Secret":
+			var s corev1.Secret
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(content, &s); err != nil {
+				return found, fmt.Errorf("could not parse Secret '%s': %w", namespacedName, err)
+			}
+
+			found.Secrets[namespacedName] = s
+
+		case gvk.Group == "" && gvk.Kind == "ConfigMap":
+			var c corev1.ConfigMap
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(content, &c); err != nil {
+				return found, fmt.Errorf("could not parse ConfigMap '%s': %w", namespacedName, err)
+			}
+
+			found.ConfigMaps[namespacedName] = c
+		}
+	}
+
+	return found, nil
+}
+
+// ComposeValues composes the values of a Flux HelmRelease from its valuesFrom references followed by its inline values
+// (loosely based on upstream https://github.com/fluxcd/helm-controller/blob/main/controllers/helmrelease_controller.go#L551)
+func (r Resources) ComposeValues(hr HelmReleaseDependency) (valuesMap chartutil.Values, err error) {
+	valuesMap = chartutil.Values{}
+
+	for _, v := range hr.ValuesFrom {
+		var valuesData string
+		namespacedName := GetNamespacedNameFromStr(hr.Metadata.Namespace, v.Name)
+
+		switch v.Kind {
+		case "ConfigMap":
+			cm, ok := r.ConfigMaps[namespacedName]
+			if !ok {
+				if v.Optional {
+					continue
+				}
+				return nil, fmt.Errorf("could not find values %s '%s'", v.Kind, namespacedName)
+			}
+
+			valuesData, ok = cm.Data[v.GetValuesKey()]
+			if !ok {
+				if v.Optional {
+					continue
+				}
+				return nil, fmt.Errorf("missing key '%s' in %s '%s'", v.GetValuesKey(), v.Kind, namespacedName)
+			}
+		case "Secret":
+			sec, ok := r.Secrets[namespacedName]
+			if !ok {
+				if v.Optional {
+					continue
+				}
+				return nil, fmt.Errorf("could not find values %s '%s'", v.Kind, namespacedName)
+			}
+
+			valuesData, ok = sec.StringData[v.GetValuesKey()]
+			if !ok {
+				// Secrets read from manifests carry their values base64 encoded in data
+				data, ok := sec.Data[v.GetValuesKey()]
+				if !ok {
+					if v.Optional {
+						continue
+					}
+					return nil, fmt.Errorf("missing key '%s' in %s '%s'", v.GetValuesKey(), v.Kind, namespacedName)
+				}
+				valuesData = string(data)
+			}
+		default:
+			return nil, fmt.Errorf("unsupported ValuesReference kind '%s'", v.Kind)
+		}
+
+		values, err := chartutil.ReadValues([]byte(valuesData))
+		if err != nil {
+			return nil, fmt.Errorf("unable to read values from key '%s' in %s '%s': %w", v.GetValuesKey(), v.Kind, hr.Name(), err)
+		}
+
+		valuesMap = helpers.MergeMapRecursive(valuesMap, values)
+	}
+
+	return helpers.MergeMapRecursive(valuesMap, hr.Values), nil
+}
+
+// GetNamespacedNameFromMeta returns the namespaced name Flux objects are keyed by for an object's metadata.
+func GetNamespacedNameFromMeta(o metav1.ObjectMeta) string {
+	return GetNamespacedNameFromStr(o.Namespace, o.Name)
+}
+
+// GetNamespacedNameFromStr returns the namespaced name Flux objects are keyed by for a namespace and name.
+func GetNamespacedNameFromStr(namespace, name string) string {
+	return fmt.Sprintf("%s.%s", namespace, name)
+}
+
+// defaultNamespace returns the namespace of a reference, which Flux defaults to the namespace of the referring object.
+func defaultNamespace(namespace, referrerNamespace string) string {
+	if namespace == "" {
+		return referrerNamespace
+	}
+	return namespace
+}
@@ -0,0 +1,183 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package flux contains functions for resolving the charts and kustomizations Flux deploys from its source objects.
+package flux
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+)
+
+const testManifests = `
+apiVersion: source.toolkit.fluxcd.io/v1beta2
+kind: GitRepository
+metadata:
+  name: podinfo
+  namespace: flux-system
+spec:
+  url: https://github.com/stefanprodan/podinfo.git
+  ref:
+    tag: 6.4.0
+---
+apiVersion: source.toolkit.fluxcd.io/v1beta2
+kind: GitRepository
+metadata:
+  name: podinfo-semver
+  namespace: flux-system
+spec:
+  url: https://github.com/stefanprodan/podinfo.git
+  ref:
+    semver: ">=6.3.0 <6.5.0"
+---
+apiVersion: source.toolkit.fluxcd.io/v1beta2
+kind: GitRepository
+metadata:
+  name: podinfo-main
+  namespace: flux-system
+spec:
+  url: https://github.com/stefanprodan/podinfo.git
+  ref:
+    branch: main
+---
+apiVersion: source.toolkit.fluxcd.io/v1beta2
+kind: HelmRepository
+metadata:
+  name: bitnami
+  namespace: flux-system
+spec:
+  url: https://charts.bitnami.com/bitnami
+---
+apiVersion: helm.toolkit.fluxcd.io/v2beta1
+kind: HelmRelease
+metadata:
+  name: redis
+  namespace: flux-system
+spec:
+  chart:
+    spec:
+      chart: redis
+      version: 18.x
+      sourceRef:
+        kind: HelmRepository
+        name: bitnami
+  valuesFrom:
+    - kind: ConfigMap
+      name: redis-values
+    - kind: Secret
+      name: redis-secret-values
+      valuesKey: custom.yaml
+  values:
+    replica:
+      replicaCount: 1
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: redis-values
+  namespace: flux-system
+data:
+  values.yaml: |
+    replica:
+      replicaCount: 3
+    image:
+      tag: from-configmap
+---
+apiVersion: v1
+kind: Secret
+metadata:
+  name: redis-secret-values
+  namespace: flux-system
+data:
+  custom.yaml: aW1hZ2U6CiAgdGFnOiBmcm9tLXNlY3JldAo=
+---
+apiVersion: kustomize.toolkit.fluxcd.io/v1
+kind: Kustomization
+metadata:
+  name: podinfo
+  namespace: flux-system
+spec:
+  path: ./kustomize
+  sourceRef:
+    kind: GitRepository
+    name: podinfo
+---
+apiVersion: kustomize.config.k8s.io/v1beta1
+kind: Kustomization
+resources:
+  - deployment.yaml
+`
+
+func TestFindResources(t *testing.T) {
+	t.Parallel()
+
+	var resources []*unstructured.Unstructured
+	for _, doc := range strings.Split(testManifests, "\n---\n") {
+		content := map[string]interface{}{}
+		require.NoError(t, yaml.Unmarshal([]byte(doc), &content))
+		resources = append(resources, &unstructured.Unstructured{Object: content})
+	}
+
+	found, err := FindResources(resources)
+	require.NoError(t, err)
+
+	require.Equal(t, map[string]GitRepository{
+		"flux-system.podinfo":        {URL: "https://github.com/stefanprodan/podinfo.git", Ref: "6.4.0"},
+		"flux-system.podinfo-semver": {URL: "https://github.com/stefanprodan/podinfo.git", SemVer: ">=6.3.0 <6.5.0"},
+		"flux-system.podinfo-main":   {URL: "https://github.com/stefanprodan/podinfo.git", Ref: "refs/heads/main"},
+	}, found.GitRepositories)
+	require.Equal(t, map[string]string{"flux-system.bitnami": "https://charts.bitnami.com/bitnami"}, found.HelmRepositories)
+
+	// Only the Flux Kustomization is read, not the kustomize config
+	require.Len(t, found.Kustomizations, 1)
+	kustomization := found.Kustomizations["flux-system.podinfo"]
+	require.Equal(t, "flux-system.podinfo", kustomization.NamespacedSource)
+	require.Equal(t, "GitRepository", kustomization.SourceKind)
+	require.Equal(t, "./kustomize", kustomization.Path)
+
+	// Source refs default to the namespace of the HelmRelease
+	hr, ok := found.HelmReleases["flux-system.redis"]
+	require.True(t, ok)
+	require.Equal(t, "flux-system.bitnami", hr.NamespacedSource)
+	require.Equal(t, "HelmRepository", hr.SourceKind)
+	require.Equal(t, "redis", hr.Chart)
+	require.Equal(t, "18.x", hr.Version)
+
+	// Inline values are merged over the valuesFrom references in order
+	values, err := found.ComposeValues(hr)
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{
+		"replica": map[string]interface{}{"replicaCount": float64(1)},
+		"image":   map[string]interface{}{"tag": "from-secret"},
+	}, values.AsMap())
+
+	hr.ValuesFrom[0].Name = "missing"
+	_, err = found.ComposeValues(hr)
+	require.Error(t, err)
+
+	hr.ValuesFrom[0].Optional = true
+	_, err = found.ComposeValues(hr)
+	require.NoError(t, err)
+}
+
+// TestResolveSemVer verifies that a semver range resolves to the latest tag in the range as Flux would check out.
+func TestResolveSemVer(t *testing.T) {
+	t.Parallel()
+
+	tags := []string{"6.3.0", "v6.4.1", "6.4.0", "6.5.0", "latest", "6.4.2-rc.1"}
+
+	gitRepo := GitRepository{URL: "https://github.com/stefanprodan/podinfo.git", SemVer: ">=6.3.0 <6.5.0"}
+	require.NoError(t, gitRepo.ResolveSemVer(tags))
+	require.Equal(t, "v6.4.1", gitRepo.Ref)
+
+	gitRepo = GitRepository{URL: "https://github.com/stefanprodan/podinfo.git", SemVer: "7.x"}
+	require.Error(t, gitRepo.ResolveSemVer(tags))
+	require.Empty(t, gitRepo.Ref)
+
+	gitRepo = GitRepository{URL: "https://github.com/stefanprodan/podinfo.git", SemVer: "not a range"}
+	require.Error(t, gitRepo.ResolveSemVer(tags))
+}
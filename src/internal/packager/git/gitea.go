@@ -22,6 +22,9 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
+// giteaPageLimit is the number of items to request per page from paginated Gitea APIs.
+const giteaPageLimit = 50
+
 // CreateTokenResponse is the response given from creating a token in Gitea
 type CreateTokenResponse struct {
 	ID             int64  `json:"id"`
@@ -244,6 +247,114 @@ func (g *Git) CreatePackageRegistryToken() (CreateTokenResponse, error) {
 	return createTokenResponse, nil
 }
 
+// GiteaRepo is a repository on the Zarf git server.
+type GiteaRepo struct {
+	Name     string `json:"name"`
+	Archived bool   `json:"archived"`
+}
+
+// GetRepos uses the Gitea API to list the repos owned by the push user.
+func (g *Git) GetRepos() ([]GiteaRepo, error) {
+	message.Debugf("git.GetRepos()")
+
+	tunnel, err := g.connectToGitea()
+	if err != nil {
+		return nil, err
+	}
+	defer tunnel.Close()
+
+	tunnelURL := tunnel.HTTPEndpoint()
+
+	repos := []GiteaRepo{}
+	for page := 1; ; page++ {
+		var out []byte
+
+		getReposEndpoint := fmt.Sprintf("%s/api/v1/users/%s/repos?page=%d&limit=%d", tunnelURL, g.Server.PushUsername, page, giteaPageLimit)
+		getReposRequest, _ := netHttp.NewRequest("GET", getReposEndpoint, nil)
+		err = tunnel.Wrap(func() error {
+			out, _, err = g.DoHTTPThings(getReposRequest, g.Server.PushUsername, g.Server.PushPassword)
+			return err
+		})
+		message.Debugf("GET %s:\n%s", getReposEndpoint, string(out))
+		if err != nil {
+			return nil, err
+		}
+
+		var pageRepos []GiteaRepo
+		if err := json.Unmarshal(out, &pageRepos); err != nil {
+			return nil, err
+		}
+		repos = append(repos, pageRepos...)
+
+		if len(pageRepos) < giteaPageLimit {
+			return repos, nil
+		}
+	}
+}
+
+// PruneRepos uses the Gitea API to delete the given repos owned by the push user, or to archive them if archive is set.
+func (g *Git) PruneRepos(repoNames []string, archive bool) error {
+	message.Debugf("git.PruneRepos(%v, %t)", repoNames, archive)
+
+	tunnel, err := g.connectToGitea()
+	if err != nil {
+		return err
+	}
+	defer tunnel.Close()
+
+	tunnelURL := tunnel.HTTPEndpoint()
+
+	for _, repoName := range repoNames {
+		var out []byte
+		var statusCode int
+
+		repoEndpoint := fmt.Sprintf("%s/api/v1/repos/%s/%s", tunnelURL, g.Server.PushUsername, repoName)
+
+		var repoRequest *netHttp.Request
+		if archive {
+			archiveRepoData, _ := json.Marshal(map[string]interface{}{"archived": true})
+			repoRequest, _ = netHttp.NewRequest("PATCH", repoEndpoint, bytes.NewBuffer(archiveRepoData))
+		} else {
+			repoRequest, _ = netHttp.NewRequest("DELETE", repoEndpoint, nil)
+		}
+
+		err = tunnel.Wrap(func() error {
+			out, statusCode, err = g.DoHTTPThings(repoRequest, g.Server.PushUsername, g.Server.PushPassword)
+			return err
+		})
+		message.Debugf("%s %s:\n%s", repoRequest.Method, repoEndpoint, string(out))
+		if err != nil {
+			if statusCode == netHttp.StatusNotFound {
+				message.Debugf("Repo %s was already removed.  Skipping...", repoName)
+				continue
+			}
+
+			return fmt.Errorf("unable to prune the repo %s: %w", repoName, err)
+		}
+	}
+
+	return nil
+}
+
+// connectToGitea establishes a tunnel to the Zarf git server.
+func (g *Git) connectToGitea() (*k8s.Tunnel, error) {
+	c, err := cluster.NewCluster()
+	if err != nil {
+		return nil, err
+	}
+
+	tunnel, err := c.NewTunnel(cluster.ZarfNamespaceName, k8s.SvcResource, cluster.ZarfGitServerName, "", 0, cluster.ZarfGitServerPort)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = tunnel.Connect(); err != nil {
+		return nil, err
+	}
+
+	return tunnel, nil
+}
+
 // UpdateGiteaPVC updates the existing Gitea persistent volume claim and tells Gitea whether to create or not.
 func UpdateGiteaPVC(shouldRollBack bool) (string, error) {
 	c, err := cluster.NewCluster()
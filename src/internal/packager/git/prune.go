@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package git contains functions for interacting with git repositories.
+package git
+
+import (
+	"sort"
+
+	"github.com/defenseunicorns/zarf/src/pkg/message"
+	"github.com/defenseunicorns/zarf/src/pkg/transform"
+	"github.com/defenseunicorns/zarf/src/types"
+)
+
+// GetMirroredRepos returns the names the given repo URLs are mirrored to on the git server.
+func GetMirroredRepos(repoURLs []string) []string {
+	mirroredRepos := []string{}
+	seen := map[string]bool{}
+
+	for _, repoURL := range repoURLs {
+		repoName, err := transform.GitURLtoRepoName(repoURL)
+		if err != nil {
+			message.Debugf("Unable to get the mirrored name of the repo %s: %s", repoURL, err.Error())
+			continue
+		}
+
+		// Different refs of the same repo are mirrored to the same repo
+		if !seen[repoName] {
+			seen[repoName] = true
+			mirroredRepos = append(mirroredRepos, repoName)
+		}
+	}
+
+	return mirroredRepos
+}
+
+// GetReferencedRepos returns the names of the mirrored repos that the deployed components of the given packages use.
+// Components deployed before mirrored repos were recorded fall back to the repos in their component definition.
+func GetReferencedRepos(deployedPackages []types.DeployedPackage) map[string]bool {
+	referenced := map[string]bool{}
+
+	for _, deployedPackage := range deployedPackages {
+		definitions := map[string]types.ZarfComponent{}
+		for _, component := range deployedPackage.Data.Components {
+			definitions[component.Name] = component
+		}
+
+		for _, deployedComponent := range deployedPackage.DeployedComponents {
+			for _, repoName := range deployedComponent.MirroredRepos {
+				referenced[repoName] = true
+			}
+			for _, repoName := range GetMirroredRepos(definitions[deployedComponent.Name].Repos) {
+				referenced[repoName] = true
+			}
+		}
+	}
+
+	return referenced
+}
+
+// GetUnreferencedRepos returns the candidate repos that none of the given deployed packages reference, sorted by name.
+func GetUnreferencedRepos(candidates []string, deployedPackages []types.DeployedPackage) []string {
+	referenced := GetReferencedRepos(deployedPackages)
+
+	unreferenced := []string{}
+	seen := map[string]bool{}
+	for _, repoName := range candidates {
+		if !referenced[repoName] && !seen[repoName] {
+			seen[repoName] = true
+			unreferenced = append(unreferenced, repoName)
+		}
+	}
+
+	sort.Strings(unreferenced)
+
+	return unreferenced
+}
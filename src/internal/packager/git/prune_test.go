@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package git contains functions for interacting with git repositories.
+package git
+
+import (
+	"testing"
+
+	"github.com/defenseunicorns/zarf/src/types"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGetUnreferencedRepos verifies that Zarf only prunes repos that no deployed component uses.
+func TestGetUnreferencedRepos(t *testing.T) {
+	t.Parallel()
+
+	podinfo := GetMirroredRepos([]string{"https://github.com/stefanprodan/podinfo.git"})
+	require.Len(t, podinfo, 1)
+
+	deployedPackages := []types.DeployedPackage{
+		{
+			Name: "recorded",
+			DeployedComponents: []types.DeployedComponent{
+				{Name: "app", MirroredRepos: []string{"app-1234"}},
+			},
+		},
+		{
+			Name: "unrecorded",
+			Data: types.ZarfPackage{
+				Components: []types.ZarfComponent{
+					{Name: "flux", Repos: []string{"https://github.com/stefanprodan/podinfo.git@6.4.0"}},
+					{Name: "not-deployed", Repos: []string{"https://github.com/defenseunicorns/zarf.git"}},
+				},
+			},
+			DeployedComponents: []types.DeployedComponent{
+				{Name: "flux"},
+			},
+		},
+	}
+
+	type testCase struct {
+		name       string
+		candidates []string
+		expected   []string
+	}
+
+	testCases := []testCase{
+		{
+			name:       "recorded repos are kept",
+			candidates: []string{"app-1234", "old-5678"},
+			expected:   []string{"old-5678"},
+		},
+		{
+			name:       "repos of components deployed before repos were recorded are kept",
+			candidates: []string{podinfo[0], "old-5678"},
+			expected:   []string{"old-5678"},
+		},
+		{
+			name:       "repos of components that are not deployed are pruned",
+			candidates: GetMirroredRepos([]string{"https://github.com/defenseunicorns/zarf.git"}),
+			expected:   GetMirroredRepos([]string{"https://github.com/defenseunicorns/zarf.git"}),
+		},
+		{
+			name:       "duplicate candidates are pruned once",
+			candidates: []string{"old-5678", "b-1", "old-5678"},
+			expected:   []string{"b-1", "old-5678"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, testCase.expected, GetUnreferencedRepos(testCase.candidates, deployedPackages))
+		})
+	}
+}
+
+// TestGetMirroredRepos verifies that Zarf mirrors every ref of a repo to the same repo.
+func TestGetMirroredRepos(t *testing.T) {
+	t.Parallel()
+
+	mirroredRepos := GetMirroredRepos([]string{
+		"https://github.com/stefanprodan/podinfo.git@6.4.0",
+		"https://github.com/stefanprodan/podinfo.git@refs/heads/master",
+		"https://github.com/defenseunicorns/zarf.git",
+	})
+	require.Len(t, mirroredRepos, 2)
+	require.Contains(t, mirroredRepos[0], "podinfo-")
+	require.Contains(t, mirroredRepos[1], "zarf-")
+}
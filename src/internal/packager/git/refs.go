@@ -0,0 +1,164 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package git contains functions for interacting with git repositories.
+package git
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/defenseunicorns/zarf/src/pkg/message"
+	"github.com/defenseunicorns/zarf/src/pkg/transform"
+	"github.com/defenseunicorns/zarf/src/pkg/utils"
+	"github.com/go-git/go-git/v5"
+	goConfig "github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/transport/http"
+	"github.com/go-git/go-git/v5/storage/memory"
+)
+
+// MirroredRefName returns the name of the ref a packaged repo ref is pushed to on the git server.
+// Branches and tags keep their name while commits are pushed as the zarf-ref branch created when the repo was pulled.
+func MirroredRefName(refPlain string) plumbing.ReferenceName {
+	if plumbing.IsHash(refPlain) {
+		return plumbing.NewBranchReferenceName(fmt.Sprintf("zarf-ref-%s", refPlain))
+	}
+	return ParseRef(refPlain)
+}
+
+// GetPackagedRefs returns the hashes of the refs of the given repo URLs in a package, keyed by repo URL.
+// Repo URLs without a ref mirror the whole repo and are left out.
+func GetPackagedRefs(reposPath string, repoURLs []string) map[string]string {
+	packagedRefs := map[string]string{}
+
+	for _, repoURL := range repoURLs {
+		_, refPlain, err := transform.GitURLSplitRef(repoURL)
+		if err != nil || refPlain == emptyRef {
+			continue
+		}
+
+		hash, err := GetPackagedCommit(reposPath, repoURL)
+		if err != nil {
+			message.Debugf("Unable to find the ref %s in the packaged repo %s: %s", refPlain, repoURL, err.Error())
+			continue
+		}
+
+		packagedRefs[repoURL] = hash
+	}
+
+	return packagedRefs
+}
+
+// GetPackagedCommit returns the hash of the commit a repo URL was packaged at, which is the HEAD of the repo for URLs without a ref.
+func GetPackagedCommit(reposPath string, repoURL string) (string, error) {
+	_, refPlain, err := transform.GitURLSplitRef(repoURL)
+	if err != nil {
+		return "", err
+	}
+
+	repoFolder, err := transform.GitURLtoFolderName(repoURL)
+	if err != nil {
+		return "", err
+	}
+	repoPath := path.Join(reposPath, repoFolder)
+
+	// Fallback to the repo format from <= 0.24.x
+	if _, err := os.Stat(repoPath); os.IsNotExist(err) {
+		repoFolder, err = transform.GitURLtoRepoName(repoURL)
+		if err != nil {
+			return "", err
+		}
+		repoPath = path.Join(reposPath, repoFolder)
+	}
+
+	repo, err := git.PlainOpen(repoPath)
+	if err != nil {
+		return "", err
+	}
+
+	if refPlain == emptyRef {
+		head, err := repo.Head()
+		if err != nil {
+			return "", err
+		}
+		return head.Hash().String(), nil
+	}
+
+	// Peel annotated tags to the commit they point to rather than returning the hash of the tag object
+	hash, err := repo.ResolveRevision(plumbing.Revision(MirroredRefName(refPlain).String() + "^{commit}"))
+	if err != nil {
+		return "", err
+	}
+
+	return hash.String(), nil
+}
+
+// GetMirroredRefs returns the hashes of the refs of a repo on the git server, keyed by ref name.
+func (g *Git) GetMirroredRefs(repoURL string) (map[string]string, error) {
+	gitURLNoRef, _, err := transform.GitURLSplitRef(repoURL)
+	if err != nil {
+		return nil, err
+	}
+
+	targetURL, err := transform.GitURL(g.Server.Address, gitURLNoRef, g.Server.PushUsername)
+	if err != nil {
+		return nil, fmt.Errorf("unable to transform the git url: %w", err)
+	}
+
+	remote := git.NewRemote(memory.NewStorage(), &goConfig.RemoteConfig{
+		Name: offlineRemoteName,
+		URLs: []string{targetURL.String()},
+	})
+
+	refs, err := remote.List(&git.ListOptions{
+		Auth: &http.BasicAuth{
+			Username: g.Server.PullUsername,
+			Password: g.Server.PullPassword,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	mirroredRefs := map[string]string{}
+	for _, ref := range refs {
+		// Skip symbolic refs like HEAD as they have no hash of their own
+		if ref.Type() != plumbing.HashReference || strings.HasSuffix(ref.Name().String(), "^{}") {
+			continue
+		}
+		mirroredRefs[ref.Name().String()] = ref.Hash().String()
+	}
+
+	return mirroredRefs, nil
+}
+
+// ListRemoteTags returns the names of the tags of a remote repo.
+func ListRemoteTags(gitURL string) ([]string, error) {
+	remote := git.NewRemote(memory.NewStorage(), &goConfig.RemoteConfig{
+		Name: onlineRemoteName,
+		URLs: []string{gitURL},
+	})
+
+	// Setup git credentials if we have them, ignore if we don't.
+	listOptions := &git.ListOptions{}
+	if gitCred := utils.FindAuthForHost(gitURL); gitCred != nil {
+		listOptions.Auth = &gitCred.Auth
+	}
+
+	refs, err := remote.List(listOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	tags := []string{}
+	for _, ref := range refs {
+		if ref.Name().IsTag() && !strings.HasSuffix(ref.Name().String(), "^{}") {
+			tags = append(tags, ref.Name().Short())
+		}
+	}
+
+	return tags, nil
+}
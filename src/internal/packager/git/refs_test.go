@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package git contains functions for interacting with git repositories.
+package git
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/defenseunicorns/zarf/src/pkg/transform"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/require"
+)
+
+// createPackagedRepo creates a repo the way it is packaged for a repo URL with a commit that is tagged and branched.
+func createPackagedRepo(t *testing.T, reposPath string, repoURL string) plumbing.Hash {
+	t.Helper()
+
+	repoFolder, err := transform.GitURLtoFolderName(repoURL)
+	require.NoError(t, err)
+	repoPath := filepath.Join(reposPath, repoFolder)
+
+	repo, err := git.PlainInit(repoPath, false)
+	require.NoError(t, err)
+	worktree, err := repo.Worktree()
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(filepath.Join(repoPath, "README.md"), []byte("# zarf-public-test\n"), 0600))
+	_, err = worktree.Add("README.md")
+	require.NoError(t, err)
+
+	signature := &object.Signature{Name: "Zarf", Email: "zarf@defenseunicorns.com", When: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	commit, err := worktree.Commit("Initial commit", &git.CommitOptions{Author: signature})
+	require.NoError(t, err)
+
+	_, err = repo.CreateTag("v1.0.0", commit, nil)
+	require.NoError(t, err)
+	annotated, err := repo.CreateTag("v2.0.0", commit, &git.CreateTagOptions{Message: "v2.0.0", Tagger: signature})
+	require.NoError(t, err)
+	require.NotEqual(t, commit, annotated.Hash())
+
+	require.NoError(t, repo.Storer.SetReference(plumbing.NewHashReference(plumbing.NewBranchReferenceName("release"), commit)))
+	require.NoError(t, repo.Storer.SetReference(plumbing.NewHashReference(MirroredRefName(commit.String()), commit)))
+
+	return commit
+}
+
+// TestGetPackagedCommit verifies that every kind of ref resolves to the commit it was packaged at.
+func TestGetPackagedCommit(t *testing.T) {
+	t.Parallel()
+
+	const repoURL = "https://github.com/defenseunicorns/zarf-public-test.git"
+	reposPath := t.TempDir()
+	commit := createPackagedRepo(t, reposPath, repoURL)
+
+	type testCase struct {
+		name string
+		ref  string
+	}
+
+	testCases := []testCase{
+		{name: "no ref", ref: ""},
+		{name: "lightweight tag", ref: "@v1.0.0"},
+		{name: "annotated tag", ref: "@v2.0.0"},
+		{name: "branch", ref: "@refs/heads/release"},
+		{name: "commit", ref: "@" + commit.String()},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Packaged repos are kept in a folder per ref
+			refReposPath := t.TempDir()
+			require.Equal(t, commit, createPackagedRepo(t, refReposPath, repoURL+tc.ref))
+
+			hash, err := GetPackagedCommit(refReposPath, repoURL+tc.ref)
+			require.NoError(t, err)
+			require.Equal(t, commit.String(), hash)
+		})
+	}
+
+	_, err := GetPackagedCommit(reposPath, repoURL+"@v3.0.0")
+	require.Error(t, err)
+}
@@ -5,6 +5,7 @@
 package helm
 
 import (
+	"errors"
 	"fmt"
 	"time"
 
@@ -42,9 +43,7 @@ func (h *Helm) InstallOrUpgradeChart() (types.ConnectStrings, string, error) {
 	var output *release.Release
 
 	// If no release name is specified, use the chart name.
-	if h.chart.ReleaseName == "" {
-		h.chart.ReleaseName = h.chart.Name
-	}
+	h.chart.ReleaseName = h.ReleaseName()
 
 	// Do not wait for the chart to be ready if data injections are present.
 	if len(h.component.DataInjections) > 0 {
@@ -168,12 +167,8 @@ func (h *Helm) TemplateChart() (string, chartutil.Values, error) {
 		}
 		client.KubeVersion = parsedKubeVersion
 	}
-	client.ReleaseName = h.chart.ReleaseName
-
 	// If no release name is specified, use the chart name.
-	if client.ReleaseName == "" {
-		client.ReleaseName = h.chart.Name
-	}
+	client.ReleaseName = h.ReleaseName()
 
 	// Namespace must be specified.
 	client.Namespace = h.chart.Namespace
@@ -200,6 +195,82 @@ func (h *Helm) TemplateChart() (string, chartutil.Values, error) {
 	return manifest, chartValues, nil
 }
 
+// PlanChart renders a chart through the Zarf post-renderer without changing the cluster.
+// It returns the rendered manifest along with the manifest of the currently deployed release (empty if there is none).
+func (h *Helm) PlanChart() (rendered string, deployed string, err error) {
+	message.Debugf("helm.PlanChart()")
+	spinner := message.NewProgressSpinner("Planning helm chart %s", h.chart.Name)
+	defer spinner.Stop()
+
+	// Ensure the post-renderer does not create namespaces, secrets or adopt resources
+	h.planOnly = true
+
+	if err := h.createActionConfig(h.chart.Namespace, spinner); err != nil {
+		return "", "", fmt.Errorf("unable to initialize the K8s client: %w", err)
+	}
+
+	// Read the deployed release first as a client-only install swaps out the release storage
+	deployedRelease, err := action.NewGet(h.actionConfig).Run(h.ReleaseName())
+	if err != nil && !errors.Is(err, driver.ErrReleaseNotFound) {
+		return "", "", fmt.Errorf("unable to get the deployed release %s: %w", h.ReleaseName(), err)
+	}
+	if deployedRelease != nil {
+		deployed = deployedRelease.Manifest
+		for _, hook := range deployedRelease.Hooks {
+			deployed += fmt.Sprintf("\n---\n%s", hook.Manifest)
+		}
+	}
+
+	postRender, err := h.newRenderer()
+	if err != nil {
+		return "", "", fmt.Errorf("unable to create helm renderer: %w", err)
+	}
+
+	// Bind the helm action.
+	client := action.NewInstall(h.actionConfig)
+
+	client.DryRun = true
+	client.Replace = true // Skip the name check.
+	client.ClientOnly = true
+	client.IncludeCRDs = true
+	client.ReleaseName = h.ReleaseName()
+	client.Namespace = h.chart.Namespace
+	client.PostRenderer = postRender
+
+	loadedChart, chartValues, err := h.loadChartData()
+	if err != nil {
+		return "", "", fmt.Errorf("unable to load chart data: %w", err)
+	}
+
+	templatedChart, err := client.Run(loadedChart, chartValues)
+	if err != nil {
+		return "", "", fmt.Errorf("error generating helm chart template: %w", err)
+	}
+
+	rendered = templatedChart.Manifest
+	for _, hook := range templatedChart.Hooks {
+		rendered += fmt.Sprintf("\n---\n%s", hook.Manifest)
+	}
+
+	spinner.Success()
+
+	return rendered, deployed, nil
+}
+
+// GetReleaseManifest returns the manifest of the latest revision of a release in the cluster, without its hooks.
+func (h *Helm) GetReleaseManifest(namespace string, name string) (string, error) {
+	if err := h.createActionConfig(namespace, nil); err != nil {
+		return "", fmt.Errorf("unable to initialize the K8s client: %w", err)
+	}
+
+	deployedRelease, err := action.NewGet(h.actionConfig).Run(name)
+	if err != nil {
+		return "", err
+	}
+
+	return deployedRelease.Manifest, nil
+}
+
 // RemoveChart removes a chart from the cluster.
 func (h *Helm) RemoveChart(namespace string, name string, spinner *message.Spinner) error {
 	// Establish a new actionConfig for the namespace.
@@ -210,6 +281,71 @@ func (h *Helm) RemoveChart(namespace string, name string, spinner *message.Spinn
 	return err
 }
 
+// ReleaseName returns the name of the Helm release this chart will be installed as.
+func (h *Helm) ReleaseName() string {
+	if h.chart.ReleaseName == "" {
+		return h.chart.Name
+	}
+	return h.chart.ReleaseName
+}
+
+// GetLastDeployedRevision returns the revision of the last successfully deployed release of this chart and whether the release exists at all.
+// A release can exist without a deployed revision if all of its revisions failed (revision will then be 0).
+func (h *Helm) GetLastDeployedRevision() (revision int, exists bool, err error) {
+	if err := h.createActionConfig(h.chart.Namespace, nil); err != nil {
+		return 0, false, fmt.Errorf("unable to initialize the K8s client: %w", err)
+	}
+
+	histClient := action.NewHistory(h.actionConfig)
+	releases, err := histClient.Run(h.ReleaseName())
+	if errors.Is(err, driver.ErrReleaseNotFound) {
+		return 0, false, nil
+	} else if err != nil {
+		return 0, false, err
+	}
+
+	for _, rel := range releases {
+		if rel.Info.Status == release.StatusDeployed {
+			revision = rel.Version
+		}
+	}
+
+	return revision, true, nil
+}
+
+// IsReleaseDeployed returns whether the latest revision of a release in the cluster deployed successfully.
+func (h *Helm) IsReleaseDeployed(namespace string, name string) (bool, error) {
+	if err := h.createActionConfig(namespace, nil); err != nil {
+		return false, fmt.Errorf("unable to initialize the K8s client: %w", err)
+	}
+
+	histClient := action.NewHistory(h.actionConfig)
+	releases, err := histClient.Run(name)
+	if errors.Is(err, driver.ErrReleaseNotFound) || (err == nil && len(releases) == 0) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	latest := releases[0]
+	for _, rel := range releases {
+		if rel.Version > latest.Version {
+			latest = rel
+		}
+	}
+
+	return latest.Info.Status == release.StatusDeployed, nil
+}
+
+// RollbackChart rolls back a chart release in the cluster to the given revision.
+func (h *Helm) RollbackChart(namespace string, name string, revision int, spinner *message.Spinner) error {
+	// Establish a new actionConfig for the namespace.
+	if err := h.createActionConfig(namespace, spinner); err != nil {
+		return fmt.Errorf("unable to initialize the K8s client: %w", err)
+	}
+	return h.rollbackChart(name, revision)
+}
+
 // UpdateReleaseValues updates values for a given chart release
 // (note: this only works on single-deep charts, charts with dependencies (like loki-stack) will not work)
 func (h *Helm) UpdateReleaseValues(updatedValues map[string]interface{}) error {
@@ -39,8 +39,11 @@ type Helm struct {
 	chartOverride   *chart.Chart
 	valuesOverrides map[string]any
 
-	settings     *cli.EnvSettings
-	actionConfig *action.Configuration
+	planOnly bool
+
+	settings          *cli.EnvSettings
+	actionConfig      *action.Configuration
+	fixedActionConfig *action.Configuration
 }
 
 // Modifier is a function that modifies the Helm config.
@@ -63,12 +66,18 @@ func New(chart types.ZarfChart, chartPath string, valuesPath string, mods ...Mod
 }
 
 // NewClusterOnly returns a new Helm config struct geared toward interacting with the cluster (not packages)
-func NewClusterOnly(cfg *types.PackagerConfig, cluster *cluster.Cluster) *Helm {
-	return &Helm{
+func NewClusterOnly(cfg *types.PackagerConfig, cluster *cluster.Cluster, mods ...Modifier) *Helm {
+	h := &Helm{
 		cfg:     cfg,
 		cluster: cluster,
 		timeout: config.ZarfDefaultHelmTimeout,
 	}
+
+	for _, mod := range mods {
+		mod(h)
+	}
+
+	return h
 }
 
 // NewFromZarfManifest generates a helm chart and config from a given Zarf manifest.
@@ -141,6 +150,20 @@ func WithDeployInfo(component types.ZarfComponent, cfg *types.PackagerConfig, cl
 	}
 }
 
+// WithTimeout sets the timeout for Helm operations such as installs and rollbacks
+func WithTimeout(timeout time.Duration) Modifier {
+	return func(h *Helm) {
+		h.timeout = timeout
+	}
+}
+
+// WithActionConfig sets a pre-initialized Helm action config to use instead of connecting to the cluster (i.e. for in-memory release storage)
+func WithActionConfig(actionConfig *action.Configuration) Modifier {
+	return func(h *Helm) {
+		h.fixedActionConfig = actionConfig
+	}
+}
+
 // WithKubeVersion sets the Kube version for templating the chart
 func WithKubeVersion(kubeVersion string) Modifier {
 	return func(h *Helm) {
@@ -148,6 +171,18 @@ func WithKubeVersion(kubeVersion string) Modifier {
 	}
 }
 
+// WithValuesOverrides sets values to merge over the values files of the chart when templating it
+func WithValuesOverrides(valuesOverrides map[string]any) Modifier {
+	return func(h *Helm) {
+		h.valuesOverrides = valuesOverrides
+	}
+}
+
+// Namespace returns the namespace this chart will be installed into.
+func (h *Helm) Namespace() string {
+	return h.chart.Namespace
+}
+
 // StandardName generates a predictable full path for a helm chart for Zarf.
 func StandardName(destination string, chart types.ZarfChart) string {
 	return filepath.Join(destination, chart.Name+"-"+chart.Version)
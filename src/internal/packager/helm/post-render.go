@@ -144,7 +144,7 @@ func (r *renderer) Run(renderedManifests *bytes.Buffer) (*bytes.Buffer, error) {
 		}
 
 		// If we have been asked to adopt existing resources, process those now as well
-		if r.cfg.DeployOpts.AdoptExistingResources {
+		if r.cfg.DeployOpts.AdoptExistingResources && !r.planOnly {
 			deployedNamespace := namespace
 			if deployedNamespace == "" {
 				deployedNamespace = r.chart.Namespace
@@ -166,6 +166,11 @@ func (r *renderer) Run(renderedManifests *bytes.Buffer) (*bytes.Buffer, error) {
 		fmt.Fprintf(finalManifestsOutput, "---\n# Source: %s\n%s\n", resource.Name, resource.Content)
 	}
 
+	// When planning, report the rendered resources without touching the cluster
+	if r.planOnly {
+		return finalManifestsOutput, nil
+	}
+
 	c := r.cluster
 	existingNamespaces, _ := c.GetNamespaces()
 
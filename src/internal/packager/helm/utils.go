@@ -6,7 +6,9 @@ package helm
 
 import (
 	"fmt"
+	"slices"
 	"strconv"
+	"strings"
 
 	"github.com/defenseunicorns/zarf/src/pkg/message"
 	"github.com/defenseunicorns/zarf/src/pkg/utils/helpers"
@@ -62,6 +64,18 @@ func (h *Helm) parseChartValues() (chartutil.Values, error) {
 }
 
 func (h *Helm) createActionConfig(namespace string, spinner *message.Spinner) error {
+	// Reuse a pre-initialized action config if one was provided
+	if h.fixedActionConfig != nil {
+		h.actionConfig = h.fixedActionConfig
+		return nil
+	}
+
+	// Log to the spinner if one was provided, otherwise log at the debug level
+	log := message.Debugf
+	if spinner != nil {
+		log = spinner.Updatef
+	}
+
 	// Initialize helm SDK
 	actionConfig := new(action.Configuration)
 	// Set the setings for the helm SDK
@@ -71,10 +85,54 @@ func (h *Helm) createActionConfig(namespace string, spinner *message.Spinner) er
 	h.settings.SetNamespace(namespace)
 
 	// Setup K8s connection
-	err := actionConfig.Init(h.settings.RESTClientGetter(), namespace, "", spinner.Updatef)
+	err := actionConfig.Init(h.settings.RESTClientGetter(), namespace, "", log)
 
 	// Set the actionConfig is the received Helm pointer
 	h.actionConfig = actionConfig
 
 	return err
 }
+
+// SetValuesPath sets the value at a dot separated path (with \. for a dot within a key) of a Helm values map.
+func SetValuesPath(chartValues map[string]any, path string, value any) error {
+	keys := []string{}
+	var key strings.Builder
+	for i := 0; i < len(path); i++ {
+		switch {
+		case path[i] == '\\' && i+1 < len(path) && path[i+1] == '.':
+			key.WriteByte('.')
+			i++
+		case path[i] == '.':
+			keys = append(keys, key.String())
+			key.Reset()
+		default:
+			key.WriteByte(path[i])
+		}
+	}
+	keys = append(keys, key.String())
+
+	if slices.Contains(keys, "") {
+		return fmt.Errorf("values path %q has an empty key", path)
+	}
+
+	current := chartValues
+	for idx, name := range keys {
+		if idx == len(keys)-1 {
+			current[name] = value
+			break
+		}
+
+		next, ok := current[name]
+		if !ok {
+			next = map[string]any{}
+			current[name] = next
+		}
+		nextValues, ok := next.(map[string]any)
+		if !ok {
+			return fmt.Errorf("values path %q sets a key within %q which is not a map", path, name)
+		}
+		current = nextValues
+	}
+
+	return nil
+}
@@ -9,6 +9,9 @@ import (
 	"github.com/defenseunicorns/zarf/src/types"
 )
 
+// AllPlatforms keeps every platform of a multi-platform image index when set in ImageConfig.Platforms.
+const AllPlatforms = "all"
+
 // ImageConfig is the main struct for managing container images.
 type ImageConfig struct {
 	ImagesPath string
@@ -23,5 +26,11 @@ type ImageConfig struct {
 
 	Architectures []string
 
+	Platforms []string
+
 	RegistryOverrides map[string]string
+
+	Concurrency int
+
+	VerifyKeys []string
 }
@@ -11,6 +11,7 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"sync"
 
@@ -27,7 +28,9 @@ import (
 	"github.com/google/go-containerregistry/pkg/v1/daemon"
 	"github.com/google/go-containerregistry/pkg/v1/empty"
 	clayout "github.com/google/go-containerregistry/pkg/v1/layout"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
 	"github.com/google/go-containerregistry/pkg/v1/partial"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
 	"github.com/google/go-containerregistry/pkg/v1/stream"
 	"github.com/moby/moby/client"
 )
@@ -36,6 +39,7 @@ import (
 type ImgInfo struct {
 	RefInfo        transform.Image
 	Img            v1.Image
+	Index          v1.ImageIndex
 	HasImageLayers bool
 }
 
@@ -45,6 +49,7 @@ func (i *ImageConfig) PullAll() ([]ImgInfo, error) {
 		longer            string
 		imageCount        = len(i.ImageList)
 		refInfoToImage    = map[transform.Image]v1.Image{}
+		refInfoToIndex    = map[transform.Image]v1.ImageIndex{}
 		referenceToDigest = make(map[string]string)
 		imgInfoList       []ImgInfo
 	)
@@ -83,14 +88,10 @@ func (i *ImageConfig) PullAll() ([]ImgInfo, error) {
 				return
 			}
 
-			actualSrc := refInfo.Reference
-			if overrideHost, present := i.RegistryOverrides[refInfo.Host]; present {
-				var err error
-				actualSrc, err = transform.ImageTransformHostWithoutChecksum(overrideHost, refInfo.Reference)
-				if err != nil {
-					metadataImageConcurrency.ErrorChan <- fmt.Errorf("failed to swap override host %s for %s: %w", overrideHost, refInfo.Reference, err)
-					return
-				}
+			actualSrc, err := i.getSource(refInfo)
+			if err != nil {
+				metadataImageConcurrency.ErrorChan <- err
+				return
 			}
 
 			if metadataImageConcurrency.IsDone() {
@@ -107,13 +108,29 @@ func (i *ImageConfig) PullAll() ([]ImgInfo, error) {
 				return
 			}
 
-			metadataImageConcurrency.ProgressChan <- ImgInfo{RefInfo: refInfo, Img: img, HasImageLayers: hasImageLayers}
+			var idx v1.ImageIndex
+			if len(i.Platforms) > 0 {
+				idx, err = i.PullImageIndex(actualSrc, refInfo)
+				if err != nil {
+					metadataImageConcurrency.ErrorChan <- fmt.Errorf("failed to pull the image index of %s: %w", actualSrc, err)
+					return
+				}
+			}
+
+			if metadataImageConcurrency.IsDone() {
+				return
+			}
+
+			metadataImageConcurrency.ProgressChan <- ImgInfo{RefInfo: refInfo, Img: img, Index: idx, HasImageLayers: hasImageLayers}
 		}()
 	}
 
 	onMetadataProgress := func(finishedImage ImgInfo, iteration int) {
 		spinner.Updatef("Fetching image metadata (%d of %d): %s", iteration+1, len(i.ImageList), finishedImage.RefInfo.Reference)
 		refInfoToImage[finishedImage.RefInfo] = finishedImage.Img
+		if finishedImage.Index != nil {
+			refInfoToIndex[finishedImage.RefInfo] = finishedImage.Index
+		}
 		imgInfoList = append(imgInfoList, finishedImage)
 	}
 
@@ -133,11 +150,24 @@ func (i *ImageConfig) PullAll() ([]ImgInfo, error) {
 	totalBytes := int64(0)
 	processedLayers := make(map[string]v1.Layer)
 	for refInfo, img := range refInfoToImage {
-		// Get the byte size for this image
+		// Get the byte size for this image (and every platform kept from its index)
 		layers, err := img.Layers()
 		if err != nil {
 			return nil, fmt.Errorf("unable to get layers for image %s: %w", refInfo.Reference, err)
 		}
+		if idx, ok := refInfoToIndex[refInfo]; ok {
+			idxImages, err := getIndexImages(idx)
+			if err != nil {
+				return nil, fmt.Errorf("unable to get the images of the image index %s: %w", refInfo.Reference, err)
+			}
+			for _, idxImg := range idxImages {
+				idxLayers, err := idxImg.Layers()
+				if err != nil {
+					return nil, fmt.Errorf("unable to get layers for image %s: %w", refInfo.Reference, err)
+				}
+				layers = append(layers, idxLayers...)
+			}
+		}
 		for _, layer := range layers {
 			layerDigest, err := layer.Digest()
 			if err != nil {
@@ -171,7 +201,7 @@ func (i *ImageConfig) PullAll() ([]ImgInfo, error) {
 	}
 
 	for refInfo, img := range refInfoToImage {
-		imgDigest, err := img.Digest()
+		imgDigest, err := getDigest(img, refInfoToIndex[refInfo])
 		if err != nil {
 			return nil, fmt.Errorf("unable to get digest for image %s: %w", refInfo.Reference, err)
 		}
@@ -343,9 +373,15 @@ func (i *ImageConfig) PullAll() ([]ImgInfo, error) {
 	for refInfo, img := range refInfoToImage {
 		// Create a closure so that we can pass the refInfo and img into the goroutine
 		refInfo, img := refInfo, img
+		idx := refInfoToIndex[refInfo]
 		go func() {
-			// Save the image via crane
-			err := cranePath.WriteImage(img)
+			// Save the image (or the index with every platform that was kept) via crane
+			var err error
+			if idx != nil {
+				err = cranePath.WriteIndex(idx)
+			} else {
+				err = cranePath.WriteImage(img)
+			}
 
 			if imageSavingConcurrency.IsDone() {
 				return
@@ -365,7 +401,7 @@ func (i *ImageConfig) PullAll() ([]ImgInfo, error) {
 			}
 
 			// Get the image digest so we can set an annotation in the image.json later
-			imgDigest, err := img.Digest()
+			imgDigest, err := getDigest(img, idx)
 			if err != nil {
 				imageSavingConcurrency.ErrorChan <- err
 				return
@@ -398,22 +434,21 @@ func (i *ImageConfig) PullAll() ([]ImgInfo, error) {
 	// for every image sequentially append OCI descriptor
 
 	for refInfo, img := range refInfoToImage {
-		desc, err := partial.Descriptor(img)
-		if err != nil {
-			return nil, err
+		var desc *v1.Descriptor
+		if idx, ok := refInfoToIndex[refInfo]; ok {
+			desc, err = partial.Descriptor(idx)
+		} else {
+			desc, err = partial.Descriptor(img)
 		}
-
-		cranePath.AppendDescriptor(*desc)
 		if err != nil {
 			return nil, err
 		}
 
-		imgDigest, err := img.Digest()
-		if err != nil {
+		if err := cranePath.AppendDescriptor(*desc); err != nil {
 			return nil, err
 		}
 
-		referenceToDigest[refInfo.Reference] = imgDigest.String()
+		referenceToDigest[refInfo.Reference] = desc.Digest.String()
 	}
 
 	if err := utils.AddImageNameAnnotation(i.ImagesPath, referenceToDigest); err != nil {
@@ -495,3 +530,107 @@ func (i *ImageConfig) PullImage(src string, spinner *message.Spinner) (img v1.Im
 	return img, hasImageLayers, nil
 
 }
+
+// getSource returns the reference to pull an image from, with its registry swapped if it is overridden.
+func (i *ImageConfig) getSource(refInfo transform.Image) (string, error) {
+	overrideHost, present := i.RegistryOverrides[refInfo.Host]
+	if !present {
+		return refInfo.Reference, nil
+	}
+
+	actualSrc, err := transform.ImageTransformHostWithoutChecksum(overrideHost, refInfo.Reference)
+	if err != nil {
+		return "", fmt.Errorf("failed to swap override host %s for %s: %w", overrideHost, refInfo.Reference, err)
+	}
+
+	return actualSrc, nil
+}
+
+// PullImageIndex returns the multi-platform image index of an image in a registry with only the configured platforms (and the package's own) kept.
+// It returns nil when the image is not an index or is not in a registry (i.e. it is a tarball or in the local docker daemon).
+func (i *ImageConfig) PullImageIndex(src string, refInfo transform.Image) (v1.ImageIndex, error) {
+	if strings.HasSuffix(src, ".tar") || strings.HasSuffix(src, ".tar.gz") || strings.HasSuffix(src, ".tgz") {
+		return nil, nil
+	}
+
+	options := crane.GetOptions(config.GetCraneOptions(i.Insecure, i.Architectures...)...)
+	ref, err := name.ParseReference(src, options.Name...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse image reference: %w", err)
+	}
+
+	desc, err := remote.Get(ref, options.Remote...)
+	if err != nil {
+		message.Debugf("Keeping a single platform of %s, unable to get it from a registry: %s", src, err.Error())
+		return nil, nil
+	}
+	if !desc.MediaType.IsIndex() {
+		return nil, nil
+	}
+
+	idx, err := desc.ImageIndex()
+	if err != nil {
+		return nil, err
+	}
+
+	if slices.Contains(i.Platforms, AllPlatforms) {
+		return idx, nil
+	}
+
+	// Removing platforms changes the digest of the index which would break references pinned by digest
+	if refInfo.Digest != "" {
+		message.Warnf("Keeping every platform of %s since it is pinned by digest", src)
+		return idx, nil
+	}
+
+	platforms := []v1.Platform{{OS: "linux", Architecture: config.GetArch(i.Architectures...)}}
+	for _, platform := range i.Platforms {
+		parsed, err := v1.ParsePlatform(platform)
+		if err != nil {
+			return nil, fmt.Errorf("invalid image platform %q: %w", platform, err)
+		}
+		platforms = append(platforms, *parsed)
+	}
+
+	return mutate.RemoveManifests(idx, func(desc v1.Descriptor) bool {
+		if desc.Platform == nil || !desc.MediaType.IsImage() {
+			return true
+		}
+		for _, platform := range platforms {
+			if desc.Platform.Satisfies(platform) {
+				return false
+			}
+		}
+		return true
+	}), nil
+}
+
+// getIndexImages returns every image in a multi-platform image index.
+func getIndexImages(idx v1.ImageIndex) ([]v1.Image, error) {
+	idxManifest, err := idx.IndexManifest()
+	if err != nil {
+		return nil, err
+	}
+
+	images := []v1.Image{}
+	for _, manifest := range idxManifest.Manifests {
+		if !manifest.MediaType.IsImage() {
+			continue
+		}
+		img, err := idx.Image(manifest.Digest)
+		if err != nil {
+			return nil, err
+		}
+		images = append(images, img)
+	}
+
+	return images, nil
+}
+
+// getDigest returns the digest of the image index an image was kept with, or of the image itself.
+func getDigest(img v1.Image, idx v1.ImageIndex) (v1.Hash, error) {
+	if idx != nil {
+		return idx.Digest()
+	}
+	return img.Digest()
+}
@@ -7,6 +7,8 @@ package images
 import (
 	"fmt"
 	"net/http"
+	"sync"
+	"time"
 
 	"github.com/defenseunicorns/zarf/src/config"
 	"github.com/defenseunicorns/zarf/src/pkg/cluster"
@@ -14,49 +16,48 @@ import (
 	"github.com/defenseunicorns/zarf/src/pkg/message"
 	"github.com/defenseunicorns/zarf/src/pkg/transform"
 	"github.com/defenseunicorns/zarf/src/pkg/utils"
+	"github.com/defenseunicorns/zarf/src/pkg/utils/helpers"
 	"github.com/google/go-containerregistry/pkg/crane"
 	"github.com/google/go-containerregistry/pkg/logs"
+	"github.com/google/go-containerregistry/pkg/name"
 	v1 "github.com/google/go-containerregistry/pkg/v1"
+	clayout "github.com/google/go-containerregistry/pkg/v1/layout"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"golang.org/x/sync/errgroup"
 )
 
-// PushToZarfRegistry pushes a provided image into the configured Zarf registry
-// This function will optionally shorten the image name while appending a checksum of the original image name.
-func (i *ImageConfig) PushToZarfRegistry() error {
-	message.Debug("images.PushToZarfRegistry()")
+// defaultPushConcurrency is the number of images pushed at once when ImageConfig.Concurrency is not set.
+const defaultPushConcurrency = 3
 
-	logs.Warn.SetOutput(&message.DebugWriter{})
-	logs.Progress.SetOutput(&message.DebugWriter{})
+// pushRetries is the number of times an image is pushed before giving up, waiting pushRetryDelay (doubled after each attempt) in between.
+const pushRetries = 3
 
-	refInfoToImage := map[transform.Image]v1.Image{}
-	var totalSize int64
-	// Build an image list from the references
-	for _, refInfo := range i.ImageList {
-		img, err := utils.LoadOCIImage(i.ImagesPath, refInfo)
-		if err != nil {
-			return err
-		}
-		refInfoToImage[refInfo] = img
-		imgSize, err := calcImgSize(img)
-		if err != nil {
-			return err
-		}
-		totalSize += imgSize
-	}
+var pushRetryDelay = 5 * time.Second
+
+// imagePush is a packaged image (or multi-platform image index) and the references it still needs to be pushed to in the Zarf registry.
+type imagePush struct {
+	refInfo transform.Image
+	img     v1.Image
+	index   v1.ImageIndex
+	names   []string
+}
 
-	// If this is not a no checksum image push we will be pushing two images (the second will go faster as it checks the same layers)
-	if !i.NoChecksum {
-		totalSize = totalSize * 2
+// images returns the image of a push, or every image of its index.
+func (push imagePush) images() ([]v1.Image, error) {
+	if push.index != nil {
+		return getIndexImages(push.index)
 	}
+	return []v1.Image{push.img}, nil
+}
 
-	httpTransport := http.DefaultTransport.(*http.Transport).Clone()
-	httpTransport.TLSClientConfig.InsecureSkipVerify = i.Insecure
-	progressBar := message.NewProgressBar(totalSize, fmt.Sprintf("Pushing %d images to the zarf registry", len(i.ImageList)))
-	defer progressBar.Stop()
-	craneTransport := utils.NewTransport(httpTransport, progressBar)
+// PushToZarfRegistry pushes the provided images into the configured Zarf registry, skipping the references that already have the same digest.
+// This function will optionally shorten the image name while appending a checksum of the original image name.
+func (i *ImageConfig) PushToZarfRegistry() error {
+	message.Debug("images.PushToZarfRegistry()")
 
-	pushOptions := config.GetCraneOptions(i.Insecure, i.Architectures...)
-	pushOptions = append(pushOptions, config.GetCraneAuthOption(i.RegInfo.PushUsername, i.RegInfo.PushPassword))
-	pushOptions = append(pushOptions, crane.WithTransport(craneTransport))
+	logs.Warn.SetOutput(&message.DebugWriter{})
+	logs.Progress.SetOutput(&message.DebugWriter{})
 
 	var (
 		err         error
@@ -78,53 +79,398 @@ func (i *ImageConfig) PushToZarfRegistry() error {
 		defer tunnel.Close()
 	}
 
-	pushImage := func(img v1.Image, name string) error {
+	wrap := func(fn func() error) error {
 		if tunnel != nil {
-			return tunnel.Wrap(func() error { return crane.Push(img, name, pushOptions...) })
+			return tunnel.Wrap(fn)
+		}
+
+		return fn()
+	}
+
+	craneOptions := config.GetCraneOptions(i.Insecure, i.Architectures...)
+	craneOptions = append(craneOptions, config.GetCraneAuthOption(i.RegInfo.PushUsername, i.RegInfo.PushPassword))
+
+	concurrency := i.Concurrency
+	if concurrency < 1 {
+		concurrency = defaultPushConcurrency
+	}
+
+	spinner := message.NewProgressSpinner("Checking for %d images in the zarf registry", len(i.ImageList))
+	pushes, err := i.getImagePushes(registryURL, concurrency, craneOptions, wrap)
+	if err != nil {
+		spinner.Stop()
+		return err
+	}
+	artifactPushes, err := i.getArtifactPushes(registryURL, craneOptions, wrap)
+	if err != nil {
+		spinner.Stop()
+		return err
+	}
+
+	skipped := len(i.ImageList) - len(pushes)
+	spinner.Successf("Found %d of %d images already in the zarf registry", skipped, len(i.ImageList))
+
+	if len(pushes)+len(artifactPushes) > 0 {
+		if err := i.pushAll(append(pushes, artifactPushes...), concurrency, craneOptions, wrap); err != nil {
+			return err
+		}
+
+		message.Successf("Pushed %d images (and %d signatures, attestations and referrers) to the zarf registry", len(pushes), len(artifactPushes))
+	}
+
+	if len(i.VerifyKeys) > 0 {
+		return i.verifySignatures(registryURL, craneOptions, wrap)
+	}
+
+	return nil
+}
+
+// pushAll pushes images to the Zarf registry concurrently, retrying each image on its own.
+func (i *ImageConfig) pushAll(pushes []imagePush, concurrency int, craneOptions []crane.Option, wrap func(func() error) error) error {
+	var totalSize int64
+	for _, push := range pushes {
+		images, err := push.images()
+		if err != nil {
+			return err
 		}
+		for _, img := range images {
+			imgSize, err := calcImgSize(img)
+			if err != nil {
+				return err
+			}
+			// Every reference after the first only checks the layers that were already pushed (the progress is reported as they are found)
+			totalSize += imgSize * int64(len(push.names))
+		}
+	}
 
-		return crane.Push(img, name, pushOptions...)
+	httpTransport := http.DefaultTransport.(*http.Transport).Clone()
+	httpTransport.TLSClientConfig.InsecureSkipVerify = i.Insecure
+	progressBar := message.NewProgressBar(totalSize, fmt.Sprintf("Pushing %d images to the zarf registry", len(pushes)))
+	defer progressBar.Stop()
+	craneTransport := utils.NewTransport(httpTransport, progressBar)
+
+	pushOptions := append(craneOptions, crane.WithTransport(craneTransport))
+
+	blobs := newBlobTracker()
+	eg := errgroup.Group{}
+	eg.SetLimit(concurrency)
+
+	for _, push := range pushes {
+		push := push
+		eg.Go(func() error {
+			refTruncated := message.Truncate(push.refInfo.Reference, 55, true)
+			progressBar.UpdateTitle(fmt.Sprintf("Pushing %s", refTruncated))
+
+			finishEvent := message.TimeEvent(message.Event{Type: message.EventImagePushed, Image: push.refInfo.Reference})
+			err := helpers.RetryWithBackoff(func() error {
+				return wrap(func() error { return pushImage(push, blobs, pushOptions) })
+			}, pushRetries, pushRetryDelay, message.Warnf)
+			finishEvent(err)
+			if err != nil {
+				return fmt.Errorf("unable to push the image %s: %w", push.refInfo.Reference, err)
+			}
+
+			return nil
+		})
 	}
 
-	for refInfo, img := range refInfoToImage {
-		refTruncated := message.Truncate(refInfo.Reference, 55, true)
-		progressBar.UpdateTitle(fmt.Sprintf("Pushing %s", refTruncated))
+	return eg.Wait()
+}
+
+// getImagePushes loads the packaged images and returns the ones that have references that are missing from the Zarf registry or have a different digest there.
+func (i *ImageConfig) getImagePushes(registryURL string, concurrency int, craneOptions []crane.Option, wrap func(func() error) error) ([]imagePush, error) {
+	pushes := make([]imagePush, len(i.ImageList))
+
+	eg := errgroup.Group{}
+	eg.SetLimit(concurrency)
+
+	for idx, refInfo := range i.ImageList {
+		idx, refInfo := idx, refInfo
+		eg.Go(func() error {
+			desc, err := utils.FindOCIDescriptor(i.ImagesPath, refInfo)
+			if err != nil {
+				return err
+			}
 
-		// If this is not a no checksum image push it for use with the Zarf agent
-		if !i.NoChecksum {
-			offlineNameCRC, err := transform.ImageTransformHost(registryURL, refInfo.Reference)
+			// Images kept with several platforms are pushed back as an index
+			push := imagePush{refInfo: refInfo}
+			if desc.MediaType.IsIndex() {
+				push.index, err = utils.LoadOCIImageIndex(i.ImagesPath, refInfo)
+			} else {
+				push.img, err = utils.LoadOCIImage(i.ImagesPath, refInfo)
+			}
 			if err != nil {
 				return err
 			}
 
-			message.Debugf("crane.Push() %s:%s -> %s)", i.ImagesPath, refInfo.Reference, offlineNameCRC)
+			names := []string{}
+
+			// If this is not a no checksum image push it for use with the Zarf agent
+			if !i.NoChecksum {
+				offlineNameCRC, err := transform.ImageTransformHost(registryURL, refInfo.Reference)
+				if err != nil {
+					return err
+				}
+				names = append(names, offlineNameCRC)
+			}
 
-			err = pushImage(img, offlineNameCRC)
+			// To allow for other non-zarf workloads to easily see the images upload a non-checksum version
+			// (this may result in collisions but this is acceptable for this use case)
+			offlineName, err := transform.ImageTransformHostWithoutChecksum(registryURL, refInfo.Reference)
 			if err != nil {
 				return err
 			}
+			names = append(names, offlineName)
+
+			push.names = getMissingNames(names, desc.Digest, craneOptions, wrap)
+			pushes[idx] = push
+
+			return nil
+		})
+	}
+
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+
+	return helpers.Filter(pushes, func(push imagePush) bool { return len(push.names) > 0 }), nil
+}
+
+// getArtifactPushes loads the signatures, attestations and referrers packaged for the images and returns the ones that are missing from the Zarf registry.
+// They are pushed next to the image without a checksum since they are looked up by the digest of the image in the same repo.
+func (i *ImageConfig) getArtifactPushes(registryURL string, craneOptions []crane.Option, wrap func(func() error) error) ([]imagePush, error) {
+	pushes := []imagePush{}
+
+	for _, refInfo := range i.ImageList {
+		artifacts, err := utils.FindOCIArtifacts(i.ImagesPath, refInfo)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, desc := range artifacts {
+			artifactName := desc.Annotations[ocispec.AnnotationBaseImageName]
+			artifactRefInfo, err := transform.ParseImageRef(artifactName)
+			if err != nil {
+				return nil, err
+			}
+
+			img, err := clayout.Path(i.ImagesPath).Image(desc.Digest)
+			if err != nil {
+				return nil, err
+			}
+
+			offlineName, err := transform.ImageTransformHostWithoutChecksum(registryURL, artifactName)
+			if err != nil {
+				return nil, err
+			}
+
+			missing := getMissingNames([]string{offlineName}, desc.Digest, craneOptions, wrap)
+			if len(missing) > 0 {
+				pushes = append(pushes, imagePush{refInfo: artifactRefInfo, img: img, names: missing})
+			}
 		}
+	}
+
+	return pushes, nil
+}
 
-		// To allow for other non-zarf workloads to easily see the images upload a non-checksum version
-		// (this may result in collisions but this is acceptable for this use case)
-		offlineName, err := transform.ImageTransformHostWithoutChecksum(registryURL, refInfo.Reference)
+// getMissingNames returns the references that are not in the Zarf registry with the given digest.
+func getMissingNames(names []string, digest v1.Hash, craneOptions []crane.Option, wrap func(func() error) error) []string {
+	missing := []string{}
+
+	for _, ref := range names {
+		var remoteDesc *v1.Descriptor
+		err := wrap(func() (err error) {
+			remoteDesc, err = crane.Head(ref, craneOptions...)
+			return err
+		})
+		if err == nil && remoteDesc.Digest == digest {
+			message.Debugf("Skipping %s as it is already in the zarf registry with digest %s", ref, digest)
+			continue
+		}
+
+		message.Debugf("%s is missing from the zarf registry: %v", ref, err)
+		missing = append(missing, ref)
+	}
+
+	return missing
+}
+
+// pushImage pushes an image (or image index) to each of its missing references, uploading its layers through the blob tracker first so shared layers are only uploaded once.
+func pushImage(push imagePush, blobs *blobTracker, pushOptions []crane.Option) error {
+	options := crane.GetOptions(pushOptions...)
+
+	for idx, offlineName := range push.names {
+		ref, err := name.ParseReference(offlineName, options.Name...)
 		if err != nil {
 			return err
 		}
 
-		message.Debugf("crane.Push() %s:%s -> %s)", i.ImagesPath, refInfo.Reference, offlineName)
+		// All of the references of an image are in the same repo so the layers only need to be written for the first one
+		if idx == 0 {
+			images, err := push.images()
+			if err != nil {
+				return err
+			}
+
+			for _, img := range images {
+				layers, err := img.Layers()
+				if err != nil {
+					return err
+				}
+
+				for _, layer := range layers {
+					if err := blobs.writeLayer(ref.Context(), layer, options.Remote...); err != nil {
+						return err
+					}
+				}
+			}
+		}
+
+		message.Debugf("crane.Push() %s -> %s)", push.refInfo.Reference, offlineName)
 
-		err = pushImage(img, offlineName)
+		if push.index != nil {
+			err = remote.WriteIndex(ref, push.index, options.Remote...)
+		} else {
+			err = remote.Write(ref, push.img, options.Remote...)
+		}
 		if err != nil {
 			return err
 		}
 	}
 
-	progressBar.Successf("Pushed %d images to the zarf registry", len(i.ImageList))
-
 	return nil
 }
 
+// blobTracker keeps track of the layers uploaded to the Zarf registry during a push so that a layer shared by several images is
+// uploaded once and then mounted into the other repos.
+type blobTracker struct {
+	mu      sync.Mutex
+	uploads map[v1.Hash]*blobUpload
+}
+
+// blobUpload is a layer that has been or is being uploaded to a repo in the Zarf registry.
+type blobUpload struct {
+	repo name.Repository
+	done chan struct{}
+	err  error
+}
+
+func newBlobTracker() *blobTracker {
+	return &blobTracker{
+		uploads: map[v1.Hash]*blobUpload{},
+	}
+}
+
+// writeLayer uploads a layer to a repo, or waits for the upload of the same layer to another repo to finish and mounts it from there.
+func (bt *blobTracker) writeLayer(repo name.Repository, layer v1.Layer, options ...remote.Option) error {
+	digest, err := layer.Digest()
+	if err != nil {
+		return err
+	}
+
+	bt.mu.Lock()
+	upload, ok := bt.uploads[digest]
+	if !ok {
+		upload = &blobUpload{repo: repo, done: make(chan struct{})}
+		bt.uploads[digest] = upload
+		bt.mu.Unlock()
+
+		upload.err = remote.WriteLayer(repo, layer, options...)
+		if upload.err != nil {
+			// Forget the failed upload so that the next image (or retry) uploads the layer itself
+			bt.mu.Lock()
+			delete(bt.uploads, digest)
+			bt.mu.Unlock()
+		}
+		close(upload.done)
+
+		return upload.err
+	}
+	bt.mu.Unlock()
+
+	<-upload.done
+
+	if upload.err != nil {
+		return bt.writeLayer(repo, layer, options...)
+	}
+
+	if upload.repo.String() == repo.String() {
+		return nil
+	}
+
+	mountable := &remote.MountableLayer{Layer: layer, Reference: upload.repo.Digest(digest.String())}
+	return remote.WriteLayer(repo, mountable, options...)
+}
+
+// MissingFromZarfRegistry returns the images that are not in the configured Zarf registry with the same digest as in the package.
+func (i *ImageConfig) MissingFromZarfRegistry() (missing []transform.Image, err error) {
+	message.Debug("images.MissingFromZarfRegistry()")
+
+	logs.Warn.SetOutput(&message.DebugWriter{})
+	logs.Progress.SetOutput(&message.DebugWriter{})
+
+	craneOptions := config.GetCraneOptions(i.Insecure, i.Architectures...)
+	craneOptions = append(craneOptions, config.GetCraneAuthOption(i.RegInfo.PushUsername, i.RegInfo.PushPassword))
+
+	var tunnel *k8s.Tunnel
+
+	registryURL := i.RegInfo.Address
+
+	c, _ := cluster.NewCluster()
+	if c != nil {
+		registryURL, tunnel, err = c.ConnectToZarfRegistryEndpoint(i.RegInfo)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if tunnel != nil {
+		defer tunnel.Close()
+	}
+
+	for _, refInfo := range i.ImageList {
+		desc, err := utils.FindOCIDescriptor(i.ImagesPath, refInfo)
+		if err != nil {
+			return nil, err
+		}
+
+		var offlineName string
+		if i.NoChecksum {
+			offlineName, err = transform.ImageTransformHostWithoutChecksum(registryURL, refInfo.Reference)
+		} else {
+			offlineName, err = transform.ImageTransformHost(registryURL, refInfo.Reference)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		// Use the digest of the manifest as is, since images kept with several platforms are pushed as an index
+		var remoteDigest string
+		getDigest := func() error {
+			remoteDesc, err := crane.Head(offlineName, craneOptions...)
+			if err != nil {
+				return err
+			}
+			remoteDigest = remoteDesc.Digest.String()
+			return nil
+		}
+
+		if tunnel != nil {
+			err = tunnel.Wrap(getDigest)
+		} else {
+			err = getDigest()
+		}
+
+		if err != nil || remoteDigest != desc.Digest.String() {
+			message.Debugf("Image %s is missing from the zarf registry as %s (digest %q): %v", refInfo.Reference, offlineName, remoteDigest, err)
+			missing = append(missing, refInfo)
+		}
+	}
+
+	return missing, nil
+}
+
 func calcImgSize(img v1.Image) (int64, error) {
 	size, err := img.Size()
 	if err != nil {
@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package images provides functions for building and pushing images.
+package images
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/defenseunicorns/zarf/src/config"
+	"github.com/defenseunicorns/zarf/src/pkg/transform"
+	"github.com/google/go-containerregistry/pkg/crane"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPushAllRetry verifies that an image that fails to push is retried on its own.
+func TestPushAllRetry(t *testing.T) {
+	// Not parallel since it shortens the delay between retries for every push
+	retryDelay := pushRetryDelay
+	pushRetryDelay = 10 * time.Millisecond
+	t.Cleanup(func() { pushRetryDelay = retryDelay })
+
+	var manifestPuts atomic.Int32
+	registryURL := newTestRegistry(t, func(w http.ResponseWriter, r *http.Request) bool {
+		if r.Method == http.MethodPut && strings.Contains(r.URL.Path, "/manifests/") && manifestPuts.Add(1) == 1 {
+			w.WriteHeader(http.StatusForbidden)
+			return true
+		}
+		return false
+	})
+
+	refInfo, err := transform.ParseImageRef("ghcr.io/stefanprodan/podinfo:6.4.0")
+	require.NoError(t, err)
+	img, err := random.Image(1024, 2)
+	require.NoError(t, err)
+	offlineName := fmt.Sprintf("%s/stefanprodan/podinfo:6.4.0", registryURL)
+
+	i := ImageConfig{}
+	wrap := func(fn func() error) error { return fn() }
+	err = i.pushAll([]imagePush{{refInfo: refInfo, img: img, names: []string{offlineName}}}, 1, config.GetCraneOptions(false), wrap)
+	require.NoError(t, err)
+	require.Equal(t, int32(2), manifestPuts.Load())
+
+	digest, err := img.Digest()
+	require.NoError(t, err)
+	pushedDigest, err := crane.Digest(offlineName)
+	require.NoError(t, err)
+	require.Equal(t, digest.String(), pushedDigest)
+}
+
+// TestPushAllConcurrently verifies that images are pushed concurrently to all of their references with their shared layers uploaded once.
+func TestPushAllConcurrently(t *testing.T) {
+	t.Parallel()
+
+	shared, err := random.Layer(1024, types.DockerLayer)
+	require.NoError(t, err)
+	sharedDigest, err := shared.Digest()
+	require.NoError(t, err)
+
+	var sharedUploads atomic.Int32
+	registryURL := newTestRegistry(t, func(_ http.ResponseWriter, r *http.Request) bool {
+		if r.Method == http.MethodPut && r.URL.Query().Get("digest") == sharedDigest.String() {
+			sharedUploads.Add(1)
+		}
+		return false
+	})
+
+	pushes := []imagePush{}
+	for _, repo := range []string{"podinfo", "nginx", "busybox"} {
+		refInfo, err := transform.ParseImageRef(fmt.Sprintf("ghcr.io/defenseunicorns/%s:1.0.0", repo))
+		require.NoError(t, err)
+		unique, err := random.Layer(1024, types.DockerLayer)
+		require.NoError(t, err)
+		img, err := mutate.AppendLayers(empty.Image, shared, unique)
+		require.NoError(t, err)
+
+		names := []string{
+			fmt.Sprintf("%s/defenseunicorns/%s:1.0.0-zarf-1234567890", registryURL, repo),
+			fmt.Sprintf("%s/defenseunicorns/%s:1.0.0", registryURL, repo),
+		}
+		pushes = append(pushes, imagePush{refInfo: refInfo, img: img, names: names})
+	}
+
+	i := ImageConfig{}
+	wrap := func(fn func() error) error { return fn() }
+	require.NoError(t, i.pushAll(pushes, 3, config.GetCraneOptions(false), wrap))
+	require.Equal(t, int32(1), sharedUploads.Load())
+
+	for _, push := range pushes {
+		digest, err := push.img.Digest()
+		require.NoError(t, err)
+		for _, offlineName := range push.names {
+			pushedDigest, err := crane.Digest(offlineName)
+			require.NoError(t, err)
+			require.Equal(t, digest.String(), pushedDigest)
+		}
+	}
+}
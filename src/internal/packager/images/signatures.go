@@ -0,0 +1,228 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package images provides functions for building and pushing images.
+package images
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/defenseunicorns/zarf/src/config"
+	"github.com/defenseunicorns/zarf/src/pkg/message"
+	"github.com/defenseunicorns/zarf/src/pkg/transform"
+	"github.com/defenseunicorns/zarf/src/pkg/utils"
+	"github.com/google/go-containerregistry/pkg/crane"
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	clayout "github.com/google/go-containerregistry/pkg/v1/layout"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	ociremote "github.com/sigstore/cosign/v2/pkg/oci/remote"
+)
+
+// imageArtifact is a signature, attestation or referrer of an image in its source registry.
+type imageArtifact struct {
+	ref name.Reference
+	img v1.Image
+}
+
+// PullArtifacts saves the cosign signatures (.sig) and attestations (.att) and the referrers (i.e. SBOMs and provenance) of the
+// pulled images into the package's OCI layout so that they can be pushed alongside the images on deploy.
+func (i *ImageConfig) PullArtifacts(pulled []ImgInfo) ([]v1.Image, error) {
+	spinner := message.NewProgressSpinner("Looking up signatures and attestations for %d images", len(pulled))
+	defer spinner.Stop()
+
+	cranePath, err := clayout.FromPath(i.ImagesPath)
+	if err != nil {
+		return nil, err
+	}
+
+	artifactImages := []v1.Image{}
+
+	for idx, imgInfo := range pulled {
+		spinner.Updatef("Looking up signatures and attestations (%d of %d): %s", idx+1, len(pulled), imgInfo.RefInfo.Reference)
+
+		src, err := i.getSource(imgInfo.RefInfo)
+		if err != nil {
+			return nil, err
+		}
+
+		// Tarballs and images from the local docker daemon have nowhere to look up artifacts from
+		if strings.HasSuffix(src, ".tar") || strings.HasSuffix(src, ".tar.gz") || strings.HasSuffix(src, ".tgz") {
+			continue
+		}
+
+		// Artifacts are attached to the digest that was packaged (the image index when several platforms were kept)
+		digest, err := getDigest(imgInfo.Img, imgInfo.Index)
+		if err != nil {
+			return nil, err
+		}
+
+		artifacts, err := i.getArtifacts(src, digest)
+		if err != nil {
+			message.Warnf("Unable to look up the signatures and attestations of %s: %s", src, err.Error())
+			continue
+		}
+
+		for _, artifact := range artifacts {
+			// Name the artifact after the image reference it was packaged for rather than the (possibly overridden) source
+			artifactName, err := getArtifactName(imgInfo.RefInfo, artifact.ref)
+			if err != nil {
+				return nil, err
+			}
+
+			annotations := map[string]string{
+				ocispec.AnnotationBaseImageName: artifactName,
+				utils.ImageSubjectAnnotation:    imgInfo.RefInfo.Reference,
+			}
+			if err := cranePath.AppendImage(artifact.img, clayout.WithAnnotations(annotations)); err != nil {
+				return nil, fmt.Errorf("unable to save %s: %w", artifactName, err)
+			}
+
+			artifactImages = append(artifactImages, artifact.img)
+		}
+	}
+
+	spinner.Successf("Found %d signatures, attestations and referrers for %d images", len(artifactImages), len(pulled))
+
+	return artifactImages, nil
+}
+
+// getArtifacts returns the cosign signature and attestation and the referrers of an image digest in its source registry.
+func (i *ImageConfig) getArtifacts(src string, digest v1.Hash) ([]imageArtifact, error) {
+	options := crane.GetOptions(config.GetCraneOptions(i.Insecure, i.Architectures...)...)
+
+	ref, err := name.ParseReference(src, options.Name...)
+	if err != nil {
+		return nil, err
+	}
+	digestRef := ref.Context().Digest(digest.String())
+
+	artifacts := []imageArtifact{}
+	seen := map[v1.Hash]bool{}
+
+	addArtifact := func(artifactRef name.Reference) error {
+		img, err := remote.Image(artifactRef, options.Remote...)
+		var transportErr *transport.Error
+		if errors.As(err, &transportErr) && transportErr.StatusCode == http.StatusNotFound {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		artifactDigest, err := img.Digest()
+		if err != nil {
+			return err
+		}
+		if seen[artifactDigest] {
+			return nil
+		}
+		seen[artifactDigest] = true
+
+		message.Debugf("Found %s for %s", artifactRef, src)
+		artifacts = append(artifacts, imageArtifact{ref: artifactRef, img: img})
+
+		return nil
+	}
+
+	cosignOptions := []ociremote.Option{ociremote.WithRemoteOptions(options.Remote...)}
+	sigTag, err := ociremote.SignatureTag(digestRef, cosignOptions...)
+	if err != nil {
+		return nil, err
+	}
+	attTag, err := ociremote.AttestationTag(digestRef, cosignOptions...)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tag := range []name.Tag{sigTag, attTag} {
+		if err := addArtifact(tag); err != nil {
+			return nil, err
+		}
+	}
+
+	// Registries without the referrers API fall back to the sha256-<digest> tag schema
+	addReferrers := func() error {
+		referrers, err := remote.Referrers(digestRef, options.Remote...)
+		if err != nil {
+			return err
+		}
+		referrersManifest, err := referrers.IndexManifest()
+		if err != nil {
+			return err
+		}
+		for _, desc := range referrersManifest.Manifests {
+			if !desc.MediaType.IsImage() {
+				message.Debugf("Skipping the %s referrer %s of %s", desc.MediaType, desc.Digest, src)
+				continue
+			}
+			if err := addArtifact(ref.Context().Digest(desc.Digest.String())); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	// Keep the signatures and attestations that were found even if the referrers cannot be looked up
+	if err := addReferrers(); err != nil {
+		message.Warnf("Unable to look up the referrers of %s: %s", src, err.Error())
+	}
+
+	return artifacts, nil
+}
+
+// getArtifactName returns the name of an artifact in the repo of the image reference it was packaged for.
+func getArtifactName(refInfo transform.Image, artifactRef name.Reference) (string, error) {
+	switch ref := artifactRef.(type) {
+	case name.Tag:
+		return fmt.Sprintf("%s:%s", refInfo.Name, ref.TagStr()), nil
+	case name.Digest:
+		return fmt.Sprintf("%s@%s", refInfo.Name, ref.DigestStr()), nil
+	default:
+		return "", fmt.Errorf("unexpected artifact reference %s", artifactRef)
+	}
+}
+
+// verifySignatures verifies that every image in the Zarf registry has a cosign signature made by one of the configured public keys.
+func (i *ImageConfig) verifySignatures(registryURL string, craneOptions []crane.Option, wrap func(func() error) error) error {
+	spinner := message.NewProgressSpinner("Verifying the signatures of %d images", len(i.ImageList))
+	defer spinner.Stop()
+
+	options := crane.GetOptions(craneOptions...)
+
+	for _, refInfo := range i.ImageList {
+		spinner.Updatef("Verifying the signature of %s", refInfo.Reference)
+
+		desc, err := utils.FindOCIDescriptor(i.ImagesPath, refInfo)
+		if err != nil {
+			return err
+		}
+
+		offlineName, err := transform.ImageTransformHostWithoutChecksum(registryURL, refInfo.Reference)
+		if err != nil {
+			return err
+		}
+		ref, err := name.ParseReference(offlineName, options.Name...)
+		if err != nil {
+			return err
+		}
+
+		// Verify the digest that was packaged so a tag moved in the registry cannot change what is verified
+		digestRef := ref.Context().Digest(desc.Digest.String())
+		err = wrap(func() error {
+			return utils.CosignVerifyImageSignatures(digestRef, i.VerifyKeys, options.Remote...)
+		})
+		if err != nil {
+			return fmt.Errorf("unable to verify the signature of the image %s: %w", refInfo.Reference, err)
+		}
+	}
+
+	spinner.Successf("Verified the signatures of %d images", len(i.ImageList))
+
+	return nil
+}
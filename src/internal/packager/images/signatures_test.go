@@ -0,0 +1,190 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package images provides functions for building and pushing images.
+package images
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/defenseunicorns/zarf/src/config"
+	"github.com/defenseunicorns/zarf/src/pkg/transform"
+	"github.com/google/go-containerregistry/pkg/crane"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	clayout "github.com/google/go-containerregistry/pkg/v1/layout"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/partial"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/stretchr/testify/require"
+)
+
+// signedImage is an image in a test registry with a cosign signature and a referrer.
+type signedImage struct {
+	refInfo  transform.Image
+	img      v1.Image
+	sigTag   string
+	sig      v1.Image
+	referrer v1.Image
+}
+
+// newTestRegistry starts an in-memory registry and returns its host, requests that intercept handles (when given) do not reach the registry.
+func newTestRegistry(t *testing.T, intercept func(w http.ResponseWriter, r *http.Request) bool) string {
+	t.Helper()
+
+	handler := registry.New(registry.Logger(log.New(io.Discard, "", 0)), registry.WithReferrersSupport(true))
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if intercept != nil && intercept(w, r) {
+			return
+		}
+		handler.ServeHTTP(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	return strings.TrimPrefix(server.URL, "http://")
+}
+
+// pushSignedImage pushes an image with a cosign signature and a referrer to a test registry.
+func pushSignedImage(t *testing.T, registryURL string) signedImage {
+	t.Helper()
+
+	refInfo, err := transform.ParseImageRef(fmt.Sprintf("%s/stefanprodan/podinfo:6.4.0", registryURL))
+	require.NoError(t, err)
+
+	img, err := random.Image(1024, 1)
+	require.NoError(t, err)
+	require.NoError(t, crane.Push(img, refInfo.Reference))
+	desc, err := partial.Descriptor(img)
+	require.NoError(t, err)
+
+	sig, err := random.Image(256, 1)
+	require.NoError(t, err)
+	sigTag := fmt.Sprintf("%s-%s.sig", desc.Digest.Algorithm, desc.Digest.Hex)
+	require.NoError(t, crane.Push(sig, fmt.Sprintf("%s:%s", refInfo.Name, sigTag)))
+
+	referrer, err := random.Image(256, 1)
+	require.NoError(t, err)
+	referrer, ok := mutate.Subject(referrer, *desc).(v1.Image)
+	require.True(t, ok)
+	referrerDigest, err := referrer.Digest()
+	require.NoError(t, err)
+	require.NoError(t, crane.Push(referrer, fmt.Sprintf("%s@%s", refInfo.Name, referrerDigest)))
+
+	return signedImage{refInfo: refInfo, img: img, sigTag: sigTag, sig: sig, referrer: referrer}
+}
+
+// TestGetArtifactName verifies that artifacts are named after the image reference they were packaged for.
+func TestGetArtifactName(t *testing.T) {
+	t.Parallel()
+
+	refInfo, err := transform.ParseImageRef("ghcr.io/stefanprodan/podinfo:6.4.0")
+	require.NoError(t, err)
+
+	type testCase struct {
+		name        string
+		artifactRef name.Reference
+		expected    string
+	}
+
+	testCases := []testCase{
+		{
+			name:        "signature tag",
+			artifactRef: name.MustParseReference("registry.example.com/podinfo:sha256-3fbc632167424a6d997e74f52b878d7cc478225cffac6bc977eedfe51c7f4e79.sig"),
+			expected:    "ghcr.io/stefanprodan/podinfo:sha256-3fbc632167424a6d997e74f52b878d7cc478225cffac6bc977eedfe51c7f4e79.sig",
+		},
+		{
+			name:        "referrer digest",
+			artifactRef: name.MustParseReference("registry.example.com/podinfo@sha256:3fbc632167424a6d997e74f52b878d7cc478225cffac6bc977eedfe51c7f4e79"),
+			expected:    "ghcr.io/stefanprodan/podinfo@sha256:3fbc632167424a6d997e74f52b878d7cc478225cffac6bc977eedfe51c7f4e79",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			artifactName, err := getArtifactName(refInfo, tc.artifactRef)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, artifactName)
+		})
+	}
+}
+
+// TestPullAndPushArtifacts verifies that the signatures and referrers of an image are packaged and then pushed next to it.
+func TestPullAndPushArtifacts(t *testing.T) {
+	t.Parallel()
+
+	signed := pushSignedImage(t, newTestRegistry(t, nil))
+	zarfRegistryURL := newTestRegistry(t, nil)
+
+	imagesPath := t.TempDir()
+	_, err := clayout.Write(imagesPath, empty.Index)
+	require.NoError(t, err)
+
+	i := ImageConfig{ImagesPath: imagesPath, ImageList: []transform.Image{signed.refInfo}}
+	artifacts, err := i.PullArtifacts([]ImgInfo{{RefInfo: signed.refInfo, Img: signed.img}})
+	require.NoError(t, err)
+	require.Len(t, artifacts, 2)
+
+	craneOptions := config.GetCraneOptions(false)
+	wrap := func(fn func() error) error { return fn() }
+	pushes, err := i.getArtifactPushes(zarfRegistryURL, craneOptions, wrap)
+	require.NoError(t, err)
+	require.Len(t, pushes, 2)
+	require.NoError(t, i.pushAll(pushes, 2, craneOptions, wrap))
+
+	sigDigest, err := signed.sig.Digest()
+	require.NoError(t, err)
+	pushedSigDigest, err := crane.Digest(fmt.Sprintf("%s/stefanprodan/podinfo:%s", zarfRegistryURL, signed.sigTag))
+	require.NoError(t, err)
+	require.Equal(t, sigDigest.String(), pushedSigDigest)
+
+	referrerDigest, err := signed.referrer.Digest()
+	require.NoError(t, err)
+	_, err = crane.Digest(fmt.Sprintf("%s/stefanprodan/podinfo@%s", zarfRegistryURL, referrerDigest))
+	require.NoError(t, err)
+
+	// Artifacts already in the Zarf registry are not pushed again
+	pushes, err = i.getArtifactPushes(zarfRegistryURL, craneOptions, wrap)
+	require.NoError(t, err)
+	require.Empty(t, pushes)
+}
+
+// TestGetArtifactsReferrersFailure verifies that the signatures of an image are kept when its referrers cannot be looked up.
+func TestGetArtifactsReferrersFailure(t *testing.T) {
+	t.Parallel()
+
+	failReferrers := &atomic.Bool{}
+	registryURL := newTestRegistry(t, func(w http.ResponseWriter, r *http.Request) bool {
+		if failReferrers.Load() && strings.Contains(r.URL.Path, "/referrers/") {
+			w.WriteHeader(http.StatusForbidden)
+			return true
+		}
+		return false
+	})
+	signed := pushSignedImage(t, registryURL)
+	failReferrers.Store(true)
+	digest, err := signed.img.Digest()
+	require.NoError(t, err)
+
+	i := ImageConfig{}
+	artifacts, err := i.getArtifacts(signed.refInfo.Reference, digest)
+	require.NoError(t, err)
+	require.Len(t, artifacts, 1)
+
+	sigDigest, err := signed.sig.Digest()
+	require.NoError(t, err)
+	artifactDigest, err := artifacts[0].img.Digest()
+	require.NoError(t, err)
+	require.Equal(t, sigDigest, artifactDigest)
+}
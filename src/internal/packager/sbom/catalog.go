@@ -28,6 +28,7 @@ import (
 	"github.com/defenseunicorns/zarf/src/pkg/message"
 	"github.com/defenseunicorns/zarf/src/pkg/transform"
 	"github.com/defenseunicorns/zarf/src/pkg/utils"
+	"github.com/defenseunicorns/zarf/src/types"
 	v1 "github.com/google/go-containerregistry/pkg/v1"
 )
 
@@ -47,7 +48,7 @@ var transformRegex = regexp.MustCompile(`(?m)[^a-zA-Z0-9\.\-]`)
 var componentPrefix = "zarf-component-"
 
 // Catalog catalogs the given components and images to create an SBOM.
-func Catalog(componentSBOMs map[string]*layout.ComponentSBOM, imageList []transform.Image, paths *layout.PackagePaths) error {
+func Catalog(componentSBOMs map[string]*layout.ComponentSBOM, imageList []transform.Image, paths *layout.PackagePaths, zarfPackage types.ZarfPackage) error {
 	imageCount := len(imageList)
 	componentCount := len(componentSBOMs)
 	builder := Builder{
@@ -128,6 +129,12 @@ func Catalog(componentSBOMs map[string]*layout.ComponentSBOM, imageList []transf
 		}
 	}
 
+	builder.spinner.Updatef("Exporting SBOMs to SPDX and CycloneDX")
+	if err := ExportSBOMs(builder.outputDir, zarfPackage); err != nil {
+		builder.spinner.Errorf(err, "Unable to export SBOMs to SPDX and CycloneDX")
+		return err
+	}
+
 	if err := paths.SBOMs.Archive(); err != nil {
 		builder.spinner.Errorf(err, "Unable to archive SBOMs")
 		return err
@@ -254,6 +261,14 @@ func (b *Builder) createFileSBOM(componentSBOM layout.ComponentSBOM, component s
 		}
 	}
 
+	// Catalog the charts, manifests and repos of the component that syft cannot
+	resourcePackages, resourceRelationships, err := catalogResources(componentSBOM)
+	if err != nil {
+		return nil, err
+	}
+	catalog.Add(resourcePackages...)
+	relationships = append(relationships, resourceRelationships...)
+
 	artifact := sbom.SBOM{
 		Descriptor: sbom.Descriptor{
 			Name: "zarf",
@@ -288,7 +303,7 @@ func (b *Builder) createFileSBOM(componentSBOM layout.ComponentSBOM, component s
 }
 
 func (b *Builder) getNormalizedFileName(identifier string) string {
-	return transformRegex.ReplaceAllString(identifier, "_")
+	return normalizeSBOMName(identifier)
 }
 
 func (b *Builder) createSBOMFile(filename string) (*os.File, error) {
@@ -0,0 +1,233 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package sbom contains tools for generating SBOMs.
+package sbom
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/anchore/syft/syft/artifact"
+	syftFile "github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/format"
+	"github.com/anchore/syft/syft/format/cyclonedxjson"
+	"github.com/anchore/syft/syft/format/spdxjson"
+	"github.com/anchore/syft/syft/format/syftjson"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/sbom"
+	"github.com/anchore/syft/syft/source"
+	"github.com/defenseunicorns/zarf/src/pkg/transform"
+	"github.com/defenseunicorns/zarf/src/pkg/utils"
+	"github.com/defenseunicorns/zarf/src/types"
+)
+
+const (
+	// SPDXDir is the directory within the SBOMs that holds the SPDX documents.
+	SPDXDir = "spdx"
+	// CycloneDXDir is the directory within the SBOMs that holds the CycloneDX documents.
+	CycloneDXDir = "cyclonedx"
+	// PackageSBOMName is the name of the SBOM that aggregates the image and component SBOMs of a package.
+	PackageSBOMName = "zarf-package"
+
+	spdxVersion      = "2.3"
+	cyclonedxVersion = "1.5"
+)
+
+// exportFormat is an SBOM format that the Syft JSON SBOMs of a package are exported to.
+type exportFormat struct {
+	dir     string
+	ext     string
+	encoder func() (sbom.FormatEncoder, error)
+	// link relates an image, chart, component or file in the package SBOM to what it belongs to in a way the format can express
+	link func(parent, child artifact.Identifiable) artifact.Relationship
+}
+
+var exportFormats = []exportFormat{
+	{
+		dir: SPDXDir,
+		ext: ".spdx.json",
+		encoder: func() (sbom.FormatEncoder, error) {
+			return spdxjson.NewFormatEncoderWithConfig(spdxjson.EncoderConfig{Version: spdxVersion, Pretty: true})
+		},
+		link: func(parent, child artifact.Identifiable) artifact.Relationship {
+			return artifact.Relationship{From: parent, To: child, Type: artifact.ContainsRelationship}
+		},
+	},
+	{
+		dir: CycloneDXDir,
+		ext: ".cdx.json",
+		encoder: func() (sbom.FormatEncoder, error) {
+			return cyclonedxjson.NewFormatEncoderWithConfig(cyclonedxjson.EncoderConfig{Version: cyclonedxVersion, Pretty: true})
+		},
+		// CycloneDX only expresses dependencies between components, so contents are a dependency of what they belong to
+		link: func(parent, child artifact.Identifiable) artifact.Relationship {
+			return artifact.Relationship{From: child, To: parent, Type: artifact.DependencyOfRelationship}
+		},
+	},
+}
+
+// ExportSBOMs writes an SPDX and a CycloneDX document for each Syft JSON SBOM in the given SBOM directory along with
+// a package-level SBOM that links each image, chart and file back to the component it belongs to.
+func ExportSBOMs(sbomDir string, zarfPackage types.ZarfPackage) error {
+	sbomFiles, err := filepath.Glob(filepath.Join(sbomDir, "*.json"))
+	if err != nil {
+		return err
+	}
+
+	docs := map[string]*sbom.SBOM{}
+	for _, sbomFile := range sbomFiles {
+		doc, err := readSyftSBOM(sbomFile)
+		if err != nil {
+			return fmt.Errorf("unable to read the SBOM %s: %w", sbomFile, err)
+		}
+		docs[strings.TrimSuffix(filepath.Base(sbomFile), ".json")] = doc
+	}
+
+	for _, f := range exportFormats {
+		encoder, err := f.encoder()
+		if err != nil {
+			return err
+		}
+
+		dir := filepath.Join(sbomDir, f.dir)
+		if err := utils.CreateDirectory(dir, 0700); err != nil {
+			return err
+		}
+
+		for name, doc := range docs {
+			if err := writeSBOM(filepath.Join(dir, name+f.ext), *doc, encoder); err != nil {
+				return err
+			}
+		}
+
+		packageDoc, err := packageSBOM(zarfPackage, docs, f.link)
+		if err != nil {
+			return err
+		}
+		if err := writeSBOM(filepath.Join(dir, PackageSBOMName+f.ext), packageDoc, encoder); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// packageSBOM merges the image and component SBOMs of a package into one SBOM that describes the package,
+// linking each component to its images and to the charts, manifests, repos and files cataloged in its component SBOM.
+func packageSBOM(zarfPackage types.ZarfPackage, docs map[string]*sbom.SBOM, link func(parent, child artifact.Identifiable) artifact.Relationship) (sbom.SBOM, error) {
+	collection := pkg.NewCollection()
+	relationships := []artifact.Relationship{}
+
+	addContents := func(parent pkg.Package, doc *sbom.SBOM, linkFiles bool) {
+		for _, p := range doc.Artifacts.Packages.Sorted() {
+			collection.Add(p)
+			relationships = append(relationships, link(parent, p))
+
+			if linkFiles {
+				for _, location := range p.Locations.ToSlice() {
+					relationships = append(relationships, link(parent, location.Coordinates))
+				}
+			}
+		}
+		relationships = append(relationships, doc.Relationships...)
+	}
+
+	for _, component := range zarfPackage.Components {
+		componentPkg := newZarfPackage("component", component.Name, zarfPackage.Metadata.Version, nil)
+		collection.Add(componentPkg)
+
+		for _, src := range component.Images {
+			refInfo, err := transform.ParseImageRef(src)
+			if err != nil {
+				return sbom.SBOM{}, fmt.Errorf("failed to create ref for image %s: %w", src, err)
+			}
+
+			version := refInfo.Tag
+			if refInfo.Digest != "" {
+				version = refInfo.Digest
+			}
+			imagePkg := newZarfPackage("image", refInfo.Name, version, nil)
+			collection.Add(imagePkg)
+			relationships = append(relationships, link(componentPkg, imagePkg))
+
+			if doc, ok := docs[normalizeSBOMName(refInfo.Reference)]; ok {
+				addContents(imagePkg, doc, false)
+			}
+		}
+
+		if doc, ok := docs[normalizeSBOMName(componentPrefix+component.Name)]; ok {
+			addContents(componentPkg, doc, true)
+		}
+	}
+
+	return sbom.SBOM{
+		Descriptor: sbom.Descriptor{
+			Name: "zarf",
+		},
+		Source: source.Description{
+			ID:      PackageSBOMName,
+			Name:    zarfPackage.Metadata.Name,
+			Version: zarfPackage.Metadata.Version,
+		},
+		Artifacts: sbom.Artifacts{
+			Packages: collection,
+		},
+		Relationships: relationships,
+	}, nil
+}
+
+// newZarfPackage returns an SBOM package for a component, image, chart, manifest or repo of a Zarf package,
+// the kind is part of its type so that a chart and a component of the same name remain distinct.
+func newZarfPackage(kind, name, version string, qualifiers url.Values, locations ...syftFile.Location) pkg.Package {
+	purl := fmt.Sprintf("pkg:generic/zarf-%s/%s", kind, url.PathEscape(name))
+	if version != "" {
+		purl = fmt.Sprintf("%s@%s", purl, url.PathEscape(version))
+	}
+	if len(qualifiers) > 0 {
+		purl = fmt.Sprintf("%s?%s", purl, qualifiers.Encode())
+	}
+
+	p := pkg.Package{
+		Name:      name,
+		Version:   version,
+		FoundBy:   "zarf",
+		Type:      pkg.Type(fmt.Sprintf("zarf-%s", kind)),
+		PURL:      purl,
+		Locations: syftFile.NewLocationSet(locations...),
+		Licenses:  pkg.NewLicenseSet(),
+	}
+	p.SetID()
+
+	return p
+}
+
+// readSyftSBOM reads a Syft JSON SBOM.
+func readSyftSBOM(sbomFile string) (*sbom.SBOM, error) {
+	f, err := os.Open(sbomFile)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	doc, _, _, err := syftjson.NewFormatDecoder().Decode(f)
+	return doc, err
+}
+
+// writeSBOM encodes an SBOM into the given file.
+func writeSBOM(path string, doc sbom.SBOM, encoder sbom.FormatEncoder) error {
+	data, err := format.Encode(doc, encoder)
+	if err != nil {
+		return fmt.Errorf("unable to encode the SBOM %s: %w", filepath.Base(path), err)
+	}
+
+	return utils.WriteFile(path, data)
+}
+
+// normalizeSBOMName returns the name an image or component SBOM is written under.
+func normalizeSBOMName(identifier string) string {
+	return transformRegex.ReplaceAllString(identifier, "_")
+}
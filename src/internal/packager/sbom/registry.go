@@ -0,0 +1,177 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package sbom contains tools for generating SBOMs.
+package sbom
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/defenseunicorns/zarf/src/config"
+	"github.com/defenseunicorns/zarf/src/pkg/cluster"
+	"github.com/defenseunicorns/zarf/src/pkg/layout"
+	"github.com/defenseunicorns/zarf/src/pkg/message"
+	"github.com/defenseunicorns/zarf/src/pkg/utils"
+	"github.com/defenseunicorns/zarf/src/types"
+	"github.com/google/go-containerregistry/pkg/crane"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+	"github.com/google/go-containerregistry/pkg/v1/tarball"
+	ocitypes "github.com/google/go-containerregistry/pkg/v1/types"
+	"github.com/mholt/archiver/v3"
+)
+
+// sbomsMediaType is the media type of the layer that holds the SBOMs of a deployed package
+const sbomsMediaType = "application/vnd.zarf.sboms.layer.v1.tar+gzip"
+
+// PushToZarfRegistry stores the SBOMs of a deployed package in the Zarf registry so that they can be retrieved and re-scanned later.
+// Each version of a package keeps a single copy of its SBOMs that is replaced when it is redeployed.
+func PushToZarfRegistry(c *cluster.Cluster, sbomDir string, pkg types.ZarfPackage, regInfo types.RegistryInfo) error {
+	spinner := message.NewProgressSpinner("Storing the SBOMs of %s in the zarf registry", pkg.Metadata.Name)
+	defer spinner.Stop()
+
+	options := append(config.GetCraneOptions(config.CommonOptions.Insecure), config.GetCraneAuthOption(regInfo.PushUsername, regInfo.PushPassword))
+
+	err := withZarfRegistry(c, regInfo, func(registryURL string) error {
+		return pushSBOMs(registryURL, sbomDir, pkg, options...)
+	})
+	if err != nil {
+		return err
+	}
+
+	spinner.Successf("Stored the SBOMs of %s in the zarf registry", pkg.Metadata.Name)
+
+	return nil
+}
+
+// PullFromZarfRegistry retrieves the SBOMs that a deployed package stored in the Zarf registry into the given directory.
+func PullFromZarfRegistry(c *cluster.Cluster, pkg types.ZarfPackage, regInfo types.RegistryInfo, dst string) error {
+	options := append(config.GetCraneOptions(config.CommonOptions.Insecure), config.GetCraneAuthOption(regInfo.PullUsername, regInfo.PullPassword))
+
+	return withZarfRegistry(c, regInfo, func(registryURL string) error {
+		return pullSBOMs(registryURL, pkg, dst, options...)
+	})
+}
+
+// DeleteFromZarfRegistry deletes the SBOMs that a removed package stored in the Zarf registry.
+func DeleteFromZarfRegistry(c *cluster.Cluster, pkg types.ZarfPackage, regInfo types.RegistryInfo) error {
+	options := append(config.GetCraneOptions(config.CommonOptions.Insecure), config.GetCraneAuthOption(regInfo.PushUsername, regInfo.PushPassword))
+
+	return withZarfRegistry(c, regInfo, func(registryURL string) error {
+		return deleteSBOMs(registryURL, pkg, options...)
+	})
+}
+
+// pushSBOMs pushes the SBOMs in sbomDir to the registry at registryURL as a single layer image.
+func pushSBOMs(registryURL, sbomDir string, pkg types.ZarfPackage, options ...crane.Option) error {
+	tmpDir, err := utils.MakeTempDir(config.CommonOptions.TempDirectory)
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	sbomTar := filepath.Join(tmpDir, layout.SBOMTar)
+	if err := utils.CreateReproducibleTarballFromDir(sbomDir, "", sbomTar); err != nil {
+		return err
+	}
+
+	layer, err := tarball.LayerFromFile(sbomTar, tarball.WithMediaType(sbomsMediaType))
+	if err != nil {
+		return err
+	}
+	img, err := mutate.AppendLayers(empty.Image, layer)
+	if err != nil {
+		return err
+	}
+	img = mutate.MediaType(img, ocitypes.OCIManifestSchema1)
+	img = mutate.ConfigMediaType(img, ocitypes.OCIConfigJSON)
+
+	return crane.Push(img, cluster.PackageSBOMReference(registryURL, pkg), options...)
+}
+
+// pullSBOMs pulls the SBOMs of a package from the registry at registryURL and unarchives them into the SBOM directory of dst.
+func pullSBOMs(registryURL string, pkg types.ZarfPackage, dst string, options ...crane.Option) error {
+	img, err := crane.Pull(cluster.PackageSBOMReference(registryURL, pkg), options...)
+	if err != nil {
+		return err
+	}
+
+	layers, err := img.Layers()
+	if err != nil {
+		return err
+	}
+	if len(layers) != 1 {
+		return fmt.Errorf("expected the SBOMs of %s to be a single layer, found %d", pkg.Metadata.Name, len(layers))
+	}
+
+	sbomTar := filepath.Join(dst, layout.SBOMTar)
+	if err := writeLayer(layers[0], sbomTar); err != nil {
+		return err
+	}
+
+	if err := archiver.Unarchive(sbomTar, filepath.Join(dst, layout.SBOMDir)); err != nil {
+		return err
+	}
+
+	return os.Remove(sbomTar)
+}
+
+// writeLayer writes the uncompressed contents of a layer to a file.
+func writeLayer(layer v1.Layer, path string) error {
+	rc, err := layer.Uncompressed()
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = io.Copy(f, rc)
+	return err
+}
+
+// deleteSBOMs deletes the SBOMs of a package from the registry at registryURL, ignoring packages that did not store any.
+func deleteSBOMs(registryURL string, pkg types.ZarfPackage, options ...crane.Option) error {
+	sbomRef := cluster.PackageSBOMReference(registryURL, pkg)
+
+	// Delete the manifest the tag points to, splitting on the last colon so registry ports are kept with the repository name
+	digest, err := crane.Digest(sbomRef, options...)
+	if err == nil {
+		err = crane.Delete(fmt.Sprintf("%s@%s", sbomRef[:strings.LastIndex(sbomRef, ":")], digest), options...)
+	}
+
+	var transportErr *transport.Error
+	if errors.As(err, &transportErr) && transportErr.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	return err
+}
+
+// withZarfRegistry runs a function against the Zarf registry, tunneling to it when it is internal to the cluster.
+func withZarfRegistry(c *cluster.Cluster, regInfo types.RegistryInfo, fn func(registryURL string) error) error {
+	registryURL, tunnel, err := c.ConnectToZarfRegistryEndpoint(regInfo)
+	if err != nil {
+		return err
+	}
+
+	if tunnel == nil {
+		return fn(registryURL)
+	}
+	defer tunnel.Close()
+
+	return tunnel.Wrap(func() error {
+		return fn(registryURL)
+	})
+}
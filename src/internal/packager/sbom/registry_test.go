@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package sbom contains tools for generating SBOMs.
+package sbom
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/defenseunicorns/zarf/src/pkg/cluster"
+	"github.com/defenseunicorns/zarf/src/pkg/layout"
+	"github.com/defenseunicorns/zarf/src/types"
+	"github.com/google/go-containerregistry/pkg/crane"
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/stretchr/testify/require"
+)
+
+// TestStoreSBOMs verifies that the SBOMs of a package can be stored, replaced, retrieved for a rescan and deleted.
+func TestStoreSBOMs(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(registry.New(registry.Logger(log.New(io.Discard, "", 0))))
+	defer server.Close()
+	registryURL := strings.TrimPrefix(server.URL, "http://")
+
+	pkg := types.ZarfPackage{Metadata: types.ZarfMetadata{Name: "podinfo", Version: "6.4.0+build.1"}}
+
+	storeAndRetrieve := func(contents string) {
+		sbomDir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(sbomDir, "podinfo.json"), []byte(contents), 0600))
+		require.NoError(t, pushSBOMs(registryURL, sbomDir, pkg))
+
+		dst := t.TempDir()
+		require.NoError(t, pullSBOMs(registryURL, pkg, dst))
+		retrieved, err := os.ReadFile(filepath.Join(dst, layout.SBOMDir, "podinfo.json"))
+		require.NoError(t, err)
+		require.Equal(t, contents, string(retrieved))
+		require.NoFileExists(t, filepath.Join(dst, layout.SBOMTar))
+	}
+
+	storeAndRetrieve(`{"artifacts":[]}`)
+	// Redeploying the same version replaces its SBOMs
+	storeAndRetrieve(`{"artifacts":[{"name":"busybox"}]}`)
+
+	digest, err := crane.Digest(cluster.PackageSBOMReference(registryURL, pkg))
+	require.NoError(t, err)
+	require.NoError(t, deleteSBOMs(registryURL, pkg))
+	_, err = crane.Digest(fmt.Sprintf("%s/%s/podinfo@%s", registryURL, cluster.ZarfSBOMRepository, digest))
+	require.Error(t, err)
+
+	// Packages that never stored their SBOMs are ignored
+	require.NoError(t, deleteSBOMs(registryURL, types.ZarfPackage{Metadata: types.ZarfMetadata{Name: "no-sboms"}}))
+}
@@ -0,0 +1,122 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package sbom contains tools for generating SBOMs.
+package sbom
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/defenseunicorns/zarf/src/config"
+	"github.com/defenseunicorns/zarf/src/pkg/cluster"
+	"github.com/defenseunicorns/zarf/src/pkg/layout"
+	"github.com/defenseunicorns/zarf/src/pkg/message"
+	"github.com/defenseunicorns/zarf/src/pkg/utils"
+)
+
+// PackageScanReport contains the vulnerability findings of the SBOMs of a deployed package.
+type PackageScanReport struct {
+	Package string       `json:"package"`
+	Version string       `json:"version,omitempty"`
+	Error   string       `json:"error,omitempty"`
+	Reports []ScanReport `json:"reports"`
+}
+
+// Rescan re-evaluates the SBOMs that every deployed package stored in the Zarf registry against an offline vulnerability DB.
+// Packages whose SBOMs cannot be retrieved are reported with the reason rather than failing the whole rescan.
+func Rescan(c *cluster.Cluster, dbPath string) ([]PackageScanReport, error) {
+	state, err := c.LoadZarfState()
+	if err != nil {
+		return nil, err
+	}
+
+	deployedPackages, errs := c.GetDeployedZarfPackages()
+	for _, err := range errs {
+		message.WarnErr(err, "Unable to read a deployed package")
+	}
+
+	scanner, err := NewScanner(dbPath)
+	if err != nil {
+		return nil, err
+	}
+	defer scanner.Close()
+
+	tmpDir, err := utils.MakeTempDir(config.CommonOptions.TempDirectory)
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	pkgReports := []PackageScanReport{}
+	for _, deployedPackage := range deployedPackages {
+		pkgReport := PackageScanReport{
+			Package: deployedPackage.Name,
+			Version: deployedPackage.Data.Metadata.Version,
+			Reports: []ScanReport{},
+		}
+
+		dst := filepath.Join(tmpDir, deployedPackage.Name)
+		if err := utils.CreateDirectory(dst, 0700); err != nil {
+			return nil, err
+		}
+
+		if deployedPackage.Data.Metadata.YOLO {
+			pkgReport.Error = "YOLO packages do not store their SBOMs in the cluster"
+		} else if err := PullFromZarfRegistry(c, deployedPackage.Data, state.RegistryInfo, dst); err != nil {
+			message.Warnf("Unable to retrieve the SBOMs of %s, was it deployed with an older version of Zarf or without SBOMs? %s", deployedPackage.Name, err.Error())
+			pkgReport.Error = err.Error()
+		} else if pkgReport.Reports, err = scanner.ScanDir(filepath.Join(dst, layout.SBOMDir)); err != nil {
+			return nil, fmt.Errorf("unable to scan the SBOMs of %s: %w", deployedPackage.Name, err)
+		}
+
+		pkgReports = append(pkgReports, pkgReport)
+	}
+
+	return pkgReports, nil
+}
+
+// CountPackageFindings returns the number of findings in the deployed package reports at or above the given severity.
+func CountPackageFindings(pkgReports []PackageScanReport, severity string) int {
+	return CountFindings(flattenPackageReports(pkgReports), severity)
+}
+
+// PrintRescanReports prints the vulnerabilities found in the images and components of every deployed package
+// followed by the findings at or above the given severity.
+func PrintRescanReports(pkgReports []PackageScanReport, severity string) {
+	message.HorizontalRule()
+	message.Title("Deployed Package Vulnerabilities", "the vulnerabilities found in the images and components of each deployed package")
+
+	if len(pkgReports) == 0 {
+		message.Note("There are no packages deployed to this cluster.")
+		return
+	}
+
+	missingData := [][]string{}
+	for _, pkgReport := range pkgReports {
+		if pkgReport.Error != "" {
+			missingData = append(missingData, []string{pkgReport.Package, pkgReport.Version, pkgReport.Error})
+		}
+	}
+
+	printScanReports(flattenPackageReports(pkgReports), severity)
+
+	if len(missingData) > 0 {
+		message.Warn("The following packages could not be scanned:")
+		message.Table([]string{"Package", "Version", "Reason"}, missingData)
+	}
+}
+
+// flattenPackageReports returns the SBOM reports of every deployed package, named after the package they belong to.
+func flattenPackageReports(pkgReports []PackageScanReport) []ScanReport {
+	reports := []ScanReport{}
+	for _, pkgReport := range pkgReports {
+		for _, report := range pkgReport.Reports {
+			report.SBOM = fmt.Sprintf("%s/%s", pkgReport.Package, report.SBOM)
+			reports = append(reports, report)
+		}
+	}
+
+	return reports
+}
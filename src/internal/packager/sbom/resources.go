@@ -0,0 +1,131 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package sbom contains tools for generating SBOMs.
+package sbom
+
+import (
+	"fmt"
+	"net/url"
+	"path/filepath"
+
+	"github.com/anchore/syft/syft/artifact"
+	syftFile "github.com/anchore/syft/syft/file"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/defenseunicorns/zarf/src/internal/packager/git"
+	"github.com/defenseunicorns/zarf/src/internal/packager/helm"
+	"github.com/defenseunicorns/zarf/src/pkg/layout"
+	"github.com/defenseunicorns/zarf/src/pkg/transform"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/chart/loader"
+)
+
+// catalogResources returns a package for each chart (and subchart), manifest and git repo of a component
+// so that the component SBOM describes everything the component delivers.
+func catalogResources(componentSBOM layout.ComponentSBOM) ([]pkg.Package, []artifact.Relationship, error) {
+	packages, relationships, err := catalogCharts(componentSBOM)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	packages = append(packages, catalogManifests(componentSBOM)...)
+
+	repoPackages, err := catalogRepos(componentSBOM)
+	if err != nil {
+		return nil, nil, err
+	}
+	packages = append(packages, repoPackages...)
+
+	return packages, relationships, nil
+}
+
+// catalogCharts returns a package for each chart of a component and its subcharts, with each subchart a dependency of its parent.
+func catalogCharts(componentSBOM layout.ComponentSBOM) ([]pkg.Package, []artifact.Relationship, error) {
+	packages := []pkg.Package{}
+	relationships := []artifact.Relationship{}
+
+	var addChart func(loadedChart *chart.Chart, sourceURL string, location syftFile.Location) pkg.Package
+	addChart = func(loadedChart *chart.Chart, sourceURL string, location syftFile.Location) pkg.Package {
+		qualifiers := url.Values{}
+		if sourceURL != "" {
+			qualifiers.Set("download_url", sourceURL)
+		}
+
+		chartPkg := newZarfPackage("chart", loadedChart.Metadata.Name, loadedChart.Metadata.Version, qualifiers, location)
+		packages = append(packages, chartPkg)
+
+		// Subcharts are packaged within their parent chart, the repository they came from is only in its dependencies
+		repositories := map[string]string{}
+		for _, dependency := range loadedChart.Metadata.Dependencies {
+			repositories[dependency.Name] = dependency.Repository
+		}
+
+		for _, subchart := range loadedChart.Dependencies() {
+			subchartPkg := addChart(subchart, repositories[subchart.Name()], location)
+			relationships = append(relationships, artifact.Relationship{
+				From: subchartPkg,
+				To:   chartPkg,
+				Type: artifact.DependencyOfRelationship,
+			})
+		}
+
+		return chartPkg
+	}
+
+	for _, zarfChart := range componentSBOM.Charts {
+		chartPath := helm.StandardName(componentSBOM.Component.Charts, zarfChart) + ".tgz"
+
+		loadedChart, err := loader.Load(chartPath)
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to load the chart %s: %w", zarfChart.Name, err)
+		}
+
+		location := syftFile.NewLocation(filepath.Join(layout.ChartsDir, filepath.Base(chartPath)))
+		addChart(loadedChart, zarfChart.URL, location)
+	}
+
+	return packages, relationships, nil
+}
+
+// catalogManifests returns a package for each manifest of a component located at the files it was rendered to.
+func catalogManifests(componentSBOM layout.ComponentSBOM) []pkg.Package {
+	packages := []pkg.Package{}
+
+	for _, manifest := range componentSBOM.Manifests {
+		locations := []syftFile.Location{}
+		for fileIdx := range manifest.Files {
+			locations = append(locations, syftFile.NewLocation(filepath.Join(layout.ManifestsDir, fmt.Sprintf("%s-%d.yaml", manifest.Name, fileIdx))))
+		}
+		for kustomizeIdx := range manifest.Kustomizations {
+			locations = append(locations, syftFile.NewLocation(filepath.Join(layout.ManifestsDir, fmt.Sprintf("kustomization-%s-%d.yaml", manifest.Name, kustomizeIdx))))
+		}
+
+		packages = append(packages, newZarfPackage("manifest", manifest.Name, "", nil, locations...))
+	}
+
+	return packages
+}
+
+// catalogRepos returns a package for each git repo of a component versioned by the commit it was packaged at.
+func catalogRepos(componentSBOM layout.ComponentSBOM) ([]pkg.Package, error) {
+	packages := []pkg.Package{}
+
+	for _, repoURL := range componentSBOM.Repos {
+		gitURLNoRef, _, err := transform.GitURLSplitRef(repoURL)
+		if err != nil {
+			return nil, err
+		}
+
+		commit, err := git.GetPackagedCommit(componentSBOM.Component.Repos, repoURL)
+		if err != nil {
+			return nil, fmt.Errorf("unable to find the packaged commit of the repo %s: %w", repoURL, err)
+		}
+
+		qualifiers := url.Values{}
+		qualifiers.Set("vcs_url", fmt.Sprintf("git+%s@%s", gitURLNoRef, commit))
+
+		packages = append(packages, newZarfPackage("repo", gitURLNoRef, commit, qualifiers))
+	}
+
+	return packages, nil
+}
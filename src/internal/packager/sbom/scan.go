@@ -0,0 +1,300 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package sbom contains tools for generating SBOMs.
+package sbom
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/anchore/grype/grype"
+	"github.com/anchore/grype/grype/db"
+	"github.com/anchore/grype/grype/matcher"
+	grypePkg "github.com/anchore/grype/grype/pkg"
+	"github.com/anchore/grype/grype/store"
+	"github.com/defenseunicorns/zarf/src/config"
+	"github.com/defenseunicorns/zarf/src/pkg/message"
+	"github.com/defenseunicorns/zarf/src/pkg/utils"
+)
+
+// VulnsDir is the directory within the SBOMs that holds the vulnerability findings of each SBOM.
+const VulnsDir = "vulns"
+
+// Severities are the vulnerability severities in increasing order.
+var Severities = []string{"negligible", "low", "medium", "high", "critical"}
+
+// unknownSeverity is the severity of findings the vulnerability DB has no metadata for.
+const unknownSeverity = "unknown"
+
+// Finding is a vulnerability matched against a package in an SBOM.
+type Finding struct {
+	ID       string   `json:"id"`
+	Severity string   `json:"severity"`
+	Package  string   `json:"package"`
+	Version  string   `json:"version"`
+	Type     string   `json:"type"`
+	FixedIn  []string `json:"fixedIn,omitempty"`
+	URLs     []string `json:"urls,omitempty"`
+}
+
+// ScanReport contains the vulnerability findings of a single image or component SBOM.
+type ScanReport struct {
+	SBOM     string    `json:"sbom"`
+	DBBuilt  time.Time `json:"dbBuilt"`
+	Findings []Finding `json:"findings"`
+}
+
+// IsValidSeverity returns true if the given severity is one that findings can be filtered on.
+func IsValidSeverity(severity string) bool {
+	return slices.Contains(Severities, strings.ToLower(severity))
+}
+
+// CountFindings returns the number of findings in the reports at or above the given severity.
+func CountFindings(reports []ScanReport, severity string) int {
+	count := 0
+	for _, report := range reports {
+		for _, finding := range report.Findings {
+			if finding.isAtLeast(severity) {
+				count++
+			}
+		}
+	}
+
+	return count
+}
+
+// isAtLeast returns true if the finding is at or above the given severity, findings of an unknown severity only match an empty one.
+func (f Finding) isAtLeast(severity string) bool {
+	return slices.Index(Severities, strings.ToLower(f.Severity)) >= slices.Index(Severities, strings.ToLower(severity))
+}
+
+// Scanner matches SBOMs against a vulnerability DB that was loaded from an offline archive.
+type Scanner struct {
+	store  *store.Store
+	built  time.Time
+	closer *db.Closer
+	dbDir  string
+}
+
+// NewScanner imports an offline Grype vulnerability DB archive without reaching out to the internet.
+func NewScanner(dbPath string) (*Scanner, error) {
+	spinner := message.NewProgressSpinner("Loading the vulnerability database %s", dbPath)
+	defer spinner.Stop()
+
+	dbDir, err := utils.MakeTempDir(config.CommonOptions.TempDirectory)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := db.Config{
+		DBRootDir: dbDir,
+	}
+
+	curator, err := db.NewCurator(cfg)
+	if err != nil {
+		os.RemoveAll(dbDir)
+		return nil, err
+	}
+	if err := curator.ImportFrom(dbPath); err != nil {
+		os.RemoveAll(dbDir)
+		return nil, fmt.Errorf("unable to import the vulnerability database %s: %w", dbPath, err)
+	}
+
+	vulnStore, status, closer, err := grype.LoadVulnerabilityDB(cfg, false)
+	if err != nil {
+		os.RemoveAll(dbDir)
+		return nil, fmt.Errorf("unable to load the vulnerability database %s: %w", dbPath, err)
+	}
+
+	spinner.Successf("Loaded the vulnerability database built %s", status.Built.Format(time.DateOnly))
+
+	return &Scanner{
+		store:  vulnStore,
+		built:  status.Built,
+		closer: closer,
+		dbDir:  dbDir,
+	}, nil
+}
+
+// Close releases the vulnerability DB and removes its files.
+func (s *Scanner) Close() {
+	if s.closer != nil {
+		s.closer.Close()
+	}
+	os.RemoveAll(s.dbDir)
+}
+
+// Scan matches the image and component SBOMs in the given SBOM directory against an offline Grype vulnerability DB archive
+// and writes the findings of each SBOM into the vulns directory next to them.
+func Scan(sbomDir string, dbPath string) ([]ScanReport, error) {
+	scanner, err := NewScanner(dbPath)
+	if err != nil {
+		return nil, err
+	}
+	defer scanner.Close()
+
+	return scanner.ScanDir(sbomDir)
+}
+
+// ScanDir matches the image and component SBOMs in the given SBOM directory against the vulnerability DB
+// and writes the findings of each SBOM into the vulns directory next to them.
+func (s *Scanner) ScanDir(sbomDir string) ([]ScanReport, error) {
+	spinner := message.NewProgressSpinner("Scanning SBOMs for vulnerabilities")
+	defer spinner.Stop()
+
+	sbomFiles, err := filepath.Glob(filepath.Join(sbomDir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	vulnsDir := filepath.Join(sbomDir, VulnsDir)
+	if err := utils.CreateDirectory(vulnsDir, 0700); err != nil {
+		return nil, err
+	}
+
+	reports := []ScanReport{}
+	for idx, sbomFile := range sbomFiles {
+		sbomName := strings.TrimSuffix(filepath.Base(sbomFile), ".json")
+		spinner.Updatef("Scanning SBOMs for vulnerabilities (%d of %d): %s", idx+1, len(sbomFiles), sbomName)
+
+		findings, err := s.scanSBOM(sbomFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to scan the SBOM %s: %w", sbomName, err)
+		}
+
+		report := ScanReport{
+			SBOM:     sbomName,
+			DBBuilt:  s.built,
+			Findings: findings,
+		}
+		if err := utils.WriteJSON(filepath.Join(vulnsDir, filepath.Base(sbomFile)), report); err != nil {
+			return nil, err
+		}
+
+		reports = append(reports, report)
+	}
+
+	spinner.Successf("Scanned %d SBOMs for vulnerabilities", len(reports))
+
+	return reports, nil
+}
+
+// ReadScanReports reads the vulnerability findings stored next to the SBOMs of a package.
+func ReadScanReports(sbomDir string) ([]ScanReport, error) {
+	reportFiles, err := filepath.Glob(filepath.Join(sbomDir, VulnsDir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	reports := []ScanReport{}
+	for _, reportFile := range reportFiles {
+		data, err := os.ReadFile(reportFile)
+		if err != nil {
+			return nil, err
+		}
+
+		var report ScanReport
+		if err := json.Unmarshal(data, &report); err != nil {
+			return nil, fmt.Errorf("unable to read the vulnerability findings %s: %w", reportFile, err)
+		}
+		reports = append(reports, report)
+	}
+
+	return reports, nil
+}
+
+// PrintScanReports prints the number of findings of each severity for every SBOM followed by the findings at or above the given severity.
+func PrintScanReports(reports []ScanReport, severity string) {
+	message.HorizontalRule()
+	message.Title("Vulnerabilities", "the vulnerabilities found in each image and component when the package was created")
+
+	if len(reports) == 0 {
+		message.Note("This package was not scanned for vulnerabilities, create it with --vuln-db to include scan results.")
+		return
+	}
+
+	printScanReports(reports, severity)
+}
+
+// printScanReports prints the summary and findings tables of the given reports.
+func printScanReports(reports []ScanReport, severity string) {
+	// Show the most severe findings first
+	severities := []string{unknownSeverity}
+	severities = append(severities, Severities...)
+	slices.Reverse(severities)
+
+	summaryData := [][]string{}
+	findingData := [][]string{}
+	for _, report := range reports {
+		counts := map[string]int{}
+		for _, finding := range report.Findings {
+			counts[strings.ToLower(finding.Severity)]++
+
+			if finding.isAtLeast(severity) {
+				findingData = append(findingData, []string{report.SBOM, finding.ID, finding.Severity, finding.Package, finding.Version, strings.Join(finding.FixedIn, ", ")})
+			}
+		}
+
+		row := []string{report.SBOM}
+		for _, s := range severities {
+			row = append(row, strconv.Itoa(counts[s]))
+		}
+		summaryData = append(summaryData, row)
+	}
+
+	message.Table(append([]string{"SBOM"}, severities...), summaryData)
+
+	if len(findingData) > 0 {
+		message.Table([]string{"SBOM", "Vulnerability", "Severity", "Package", "Version", "Fixed In"}, findingData)
+	}
+}
+
+// scanSBOM matches the packages of a Syft JSON SBOM against the vulnerability DB.
+func (s *Scanner) scanSBOM(sbomFile string) ([]Finding, error) {
+	doc, err := readSyftSBOM(sbomFile)
+	if err != nil {
+		return nil, err
+	}
+
+	packages := grypePkg.FromCollection(doc.Artifacts.Packages, grypePkg.SynthesisConfig{})
+
+	// Component SBOMs are not of a linux image and have an empty distro
+	distro := doc.Artifacts.LinuxDistribution
+	if distro != nil && distro.ID == "" {
+		distro = nil
+	}
+
+	matches := grype.FindVulnerabilitiesForPackage(*s.store, distro, matcher.NewDefaultMatchers(matcher.Config{}), packages)
+
+	findings := []Finding{}
+	for _, m := range matches.Sorted() {
+		finding := Finding{
+			ID:       m.Vulnerability.ID,
+			Severity: unknownSeverity,
+			Package:  m.Package.Name,
+			Version:  m.Package.Version,
+			Type:     string(m.Package.Type),
+			FixedIn:  m.Vulnerability.Fix.Versions,
+		}
+
+		metadata, err := s.store.GetMetadata(m.Vulnerability.ID, m.Vulnerability.Namespace)
+		if err != nil {
+			return nil, err
+		}
+		if metadata != nil {
+			finding.Severity = strings.ToLower(metadata.Severity)
+			finding.URLs = metadata.URLs
+		}
+
+		findings = append(findings, finding)
+	}
+
+	return findings, nil
+}
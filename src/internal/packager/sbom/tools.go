@@ -56,10 +56,10 @@ func OutputSBOMFiles(sourceDir, outputDir, packageName string) (string, error) {
 	return packagePath, utils.CreatePathAndCopy(sourceDir, packagePath)
 }
 
-// IsSBOMAble checks if a package has contents that an SBOM can be created on (i.e. images, files, or data injections)
+// IsSBOMAble checks if a package has contents that an SBOM can be created on (i.e. images, files, data injections, charts, manifests or repos)
 func IsSBOMAble(pkg types.ZarfPackage) bool {
 	for _, c := range pkg.Components {
-		if len(c.Images) > 0 || len(c.Files) > 0 || len(c.DataInjections) > 0 {
+		if len(c.Images) > 0 || len(c.Files) > 0 || len(c.DataInjections) > 0 || len(c.Charts) > 0 || len(c.Manifests) > 0 || len(c.Repos) > 0 {
 			return true
 		}
 	}
@@ -0,0 +1,114 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package template provides functions for templating yaml files.
+package template
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"text/template"
+
+	"github.com/Masterminds/sprig/v3"
+	"github.com/defenseunicorns/zarf/src/types"
+	"sigs.k8s.io/yaml"
+)
+
+// ApplyGoTemplate renders the file at path as a Go template and writes the result back to it.
+// Templates can reference the package variables (.Variables.NAME), constants (.Constants.NAME)
+// and Zarf builtins (.Builtins.REGISTRY) along with the repeatable Sprig functions and toYaml, fromYaml and required.
+func (values *Values) ApplyGoTemplate(component types.ZarfComponent, path string) error {
+	text, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	data, err := values.getGoTemplateData(component)
+	if err != nil {
+		return err
+	}
+
+	rendered, err := renderGoTemplate(filepath.Base(path), string(text), data)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, []byte(rendered), 0600)
+}
+
+// getGoTemplateData returns the data Go templates are rendered with for a component.
+func (values *Values) getGoTemplateData(component types.ZarfComponent) (map[string]any, error) {
+	variables := map[string]string{}
+	for key, variable := range values.config.SetVariableMap {
+		value := variable.Value
+
+		// Load the contents of file variables as the ###ZARF_VAR_ templates do
+		if variable.Type == types.FileVariableType && value != "" {
+			contents, err := os.ReadFile(value)
+			if err != nil {
+				return nil, fmt.Errorf("unable to read the file of variable %q: %w", key, err)
+			}
+			value = string(contents)
+		}
+
+		variables[key] = value
+	}
+
+	constants := map[string]string{}
+	for _, constant := range values.config.Pkg.Constants {
+		constants[constant.Name] = constant.Value
+	}
+
+	builtins := values.getBuiltins(component)
+	if builtins == nil {
+		builtins = map[string]string{}
+	}
+
+	return map[string]any{
+		"Variables": variables,
+		"Constants": constants,
+		"Builtins":  builtins,
+	}, nil
+}
+
+// renderGoTemplate renders text as a Go template with the data, failing on references to missing keys.
+func renderGoTemplate(name, text string, data any) (string, error) {
+	tmpl, err := template.New(name).Option("missingkey=error").Funcs(goTemplateFuncs()).Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("unable to parse the template %s: %w", name, err)
+	}
+
+	var rendered bytes.Buffer
+	if err := tmpl.Execute(&rendered, data); err != nil {
+		return "", fmt.Errorf("unable to render the template %s: %w", name, err)
+	}
+
+	return rendered.String(), nil
+}
+
+// goTemplateFuncs returns the functions available to Go templates, only those that give the same result on every deploy
+// (i.e. no environment, network, date or random functions) from Sprig plus the YAML helpers familiar from Helm.
+func goTemplateFuncs() template.FuncMap {
+	funcs := sprig.HermeticTxtFuncMap()
+
+	funcs["toYaml"] = func(v any) (string, error) {
+		out, err := yaml.Marshal(v)
+		return string(bytes.TrimSuffix(out, []byte("\n"))), err
+	}
+	funcs["fromYaml"] = func(s string) (map[string]any, error) {
+		m := map[string]any{}
+		err := yaml.Unmarshal([]byte(s), &m)
+		return m, err
+	}
+	funcs["required"] = func(message string, v any) (any, error) {
+		if v == nil || v == "" {
+			return nil, errors.New(message)
+		}
+		return v, nil
+	}
+
+	return funcs
+}
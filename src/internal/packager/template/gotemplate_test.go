@@ -0,0 +1,55 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package template provides functions for templating yaml files.
+package template
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderGoTemplate(t *testing.T) {
+	t.Parallel()
+
+	data := map[string]any{
+		"Variables": map[string]string{"REPLICAS": "3", "ENABLE_TLS": "true", "PASSWORD": "hunter2", "EMPTY": ""},
+		"Constants": map[string]string{"APP": "podinfo"},
+		"Builtins":  map[string]string{"REGISTRY": "127.0.0.1:31999"},
+	}
+
+	tests := []struct {
+		name      string
+		text      string
+		expected  string
+		expectErr bool
+	}{
+		{name: "values", text: "image: {{ .Builtins.REGISTRY }}/{{ .Constants.APP }}", expected: "image: 127.0.0.1:31999/podinfo"},
+		{name: "conditional", text: `{{ if eq .Variables.ENABLE_TLS "true" }}tls: on{{ else }}tls: off{{ end }}`, expected: "tls: on"},
+		{name: "math", text: "replicas: {{ add (atoi .Variables.REPLICAS) 1 }}", expected: "replicas: 4"},
+		{name: "default", text: `level: {{ .Variables.EMPTY | default "info" }}`, expected: "level: info"},
+		{name: "helpers", text: "password: {{ .Variables.PASSWORD | b64enc | quote }}", expected: `password: "aHVudGVyMg=="`},
+		{name: "loop", text: `{{ range $i, $e := until 2 }}- {{ $i }}{{ end }}`, expected: "- 0- 1"},
+		{name: "toYaml", text: `{{ dict "a" 1 | toYaml }}`, expected: "a: 1"},
+		{name: "missing key", text: "{{ .Variables.MISSING }}", expectErr: true},
+		{name: "required", text: `{{ required "EMPTY must be set" .Variables.EMPTY }}`, expectErr: true},
+		{name: "no env", text: `{{ env "HOME" }}`, expectErr: true},
+		{name: "zarf templates are left alone", text: "###ZARF_VAR_REPLICAS###", expected: "###ZARF_VAR_REPLICAS###"},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			rendered, err := renderGoTemplate(tc.name, tc.text, data)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, rendered)
+		})
+	}
+}
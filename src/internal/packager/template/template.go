@@ -17,6 +17,12 @@ import (
 	"github.com/defenseunicorns/zarf/src/pkg/utils/helpers"
 )
 
+// The data injection marker builtins, the old one preserves an existing misspelling for backwards compatibility.
+const (
+	depMarkerOld = "DATA_INJECTON_MARKER"
+	depMarkerNew = "DATA_INJECTION_MARKER"
+)
+
 // Values contains the values to be used in the template.
 type Values struct {
 	config   *types.PackagerConfig
@@ -75,56 +81,11 @@ func (values *Values) GetRegistry() string {
 func (values *Values) GetVariables(component types.ZarfComponent) (templateMap map[string]*utils.TextTemplate, deprecations map[string]string) {
 	templateMap = make(map[string]*utils.TextTemplate)
 
-	depMarkerOld := "DATA_INJECTON_MARKER"
-	depMarkerNew := "DATA_INJECTION_MARKER"
 	deprecations = map[string]string{
 		fmt.Sprintf("###ZARF_%s###", depMarkerOld): fmt.Sprintf("###ZARF_%s###", depMarkerNew),
 	}
 
-	if values.config.State != nil {
-		regInfo := values.config.State.RegistryInfo
-		gitInfo := values.config.State.GitServer
-
-		builtinMap := map[string]string{
-			"STORAGE_CLASS": values.config.State.StorageClass,
-
-			// Registry info
-			"REGISTRY":           values.registry,
-			"NODEPORT":           fmt.Sprintf("%d", regInfo.NodePort),
-			"REGISTRY_AUTH_PUSH": regInfo.PushPassword,
-			"REGISTRY_AUTH_PULL": regInfo.PullPassword,
-
-			// Git server info
-			"GIT_PUSH":      gitInfo.PushUsername,
-			"GIT_AUTH_PUSH": gitInfo.PushPassword,
-			"GIT_PULL":      gitInfo.PullUsername,
-			"GIT_AUTH_PULL": gitInfo.PullPassword,
-		}
-
-		// Include the data injection marker template if the component has data injections
-		if len(component.DataInjections) > 0 {
-			// Preserve existing misspelling for backwards compatibility
-			builtinMap[depMarkerOld] = config.GetDataInjectionMarker()
-			builtinMap[depMarkerNew] = config.GetDataInjectionMarker()
-		}
-
-		// Don't template component-specific variables for every component
-		switch component.Name {
-		case "zarf-agent":
-			agentTLS := values.config.State.AgentTLS
-			builtinMap["AGENT_CRT"] = base64.StdEncoding.EncodeToString(agentTLS.Cert)
-			builtinMap["AGENT_KEY"] = base64.StdEncoding.EncodeToString(agentTLS.Key)
-			builtinMap["AGENT_CA"] = base64.StdEncoding.EncodeToString(agentTLS.CA)
-
-		case "zarf-seed-registry", "zarf-registry":
-			builtinMap["SEED_REGISTRY"] = fmt.Sprintf("%s:%s", helpers.IPV4Localhost, config.ZarfSeedPort)
-			builtinMap["HTPASSWD"] = values.htpasswd
-			builtinMap["REGISTRY_SECRET"] = regInfo.Secret
-
-		case "logging":
-			builtinMap["LOGGING_AUTH"] = values.config.State.LoggingSecret
-		}
-
+	if builtinMap := values.getBuiltins(component); builtinMap != nil {
 		// Iterate over any custom variables and add them to the mappings for templating
 		for key, value := range builtinMap {
 			// Builtin keys are always uppercase in the format ###ZARF_KEY###
@@ -165,6 +126,58 @@ func (values *Values) GetVariables(component types.ZarfComponent) (templateMap m
 	return templateMap, deprecations
 }
 
+// getBuiltins returns the Zarf builtin values (without their ###ZARF_ prefix) for a component, or nil before the state is loaded.
+func (values *Values) getBuiltins(component types.ZarfComponent) map[string]string {
+	if values.config.State == nil {
+		return nil
+	}
+
+	regInfo := values.config.State.RegistryInfo
+	gitInfo := values.config.State.GitServer
+
+	builtinMap := map[string]string{
+		"STORAGE_CLASS": values.config.State.StorageClass,
+
+		// Registry info
+		"REGISTRY":           values.registry,
+		"NODEPORT":           fmt.Sprintf("%d", regInfo.NodePort),
+		"REGISTRY_AUTH_PUSH": regInfo.PushPassword,
+		"REGISTRY_AUTH_PULL": regInfo.PullPassword,
+
+		// Git server info
+		"GIT_PUSH":      gitInfo.PushUsername,
+		"GIT_AUTH_PUSH": gitInfo.PushPassword,
+		"GIT_PULL":      gitInfo.PullUsername,
+		"GIT_AUTH_PULL": gitInfo.PullPassword,
+	}
+
+	// Include the data injection marker template if the component has data injections
+	if len(component.DataInjections) > 0 {
+		// Preserve existing misspelling for backwards compatibility
+		builtinMap[depMarkerOld] = config.GetDataInjectionMarker()
+		builtinMap[depMarkerNew] = config.GetDataInjectionMarker()
+	}
+
+	// Don't template component-specific variables for every component
+	switch component.Name {
+	case "zarf-agent":
+		agentTLS := values.config.State.AgentTLS
+		builtinMap["AGENT_CRT"] = base64.StdEncoding.EncodeToString(agentTLS.Cert)
+		builtinMap["AGENT_KEY"] = base64.StdEncoding.EncodeToString(agentTLS.Key)
+		builtinMap["AGENT_CA"] = base64.StdEncoding.EncodeToString(agentTLS.CA)
+
+	case "zarf-seed-registry", "zarf-registry":
+		builtinMap["SEED_REGISTRY"] = fmt.Sprintf("%s:%s", helpers.IPV4Localhost, config.ZarfSeedPort)
+		builtinMap["HTPASSWD"] = values.htpasswd
+		builtinMap["REGISTRY_SECRET"] = regInfo.Secret
+
+	case "logging":
+		builtinMap["LOGGING_AUTH"] = values.config.State.LoggingSecret
+	}
+
+	return builtinMap
+}
+
 // Apply renders the template and writes the result to the given path.
 func (values *Values) Apply(component types.ZarfComponent, path string, ignoreReady bool) error {
 	// If Apply() is called before all values are loaded, fail unless ignoreReady is true
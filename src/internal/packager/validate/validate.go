@@ -11,6 +11,7 @@ import (
 
 	"github.com/defenseunicorns/zarf/src/config"
 	"github.com/defenseunicorns/zarf/src/config/lang"
+	"github.com/defenseunicorns/zarf/src/internal/packager/variables"
 	"github.com/defenseunicorns/zarf/src/pkg/utils/helpers"
 	"github.com/defenseunicorns/zarf/src/types"
 )
@@ -79,6 +80,60 @@ func Run(pkg types.ZarfPackage) error {
 		}
 	}
 
+	return validateComponentDependencies(pkg.Components)
+}
+
+// validateComponentDependencies ensures every dependsOn entry names another component and that there are no cycles.
+func validateComponentDependencies(components []types.ZarfComponent) error {
+	dependencies := make(map[string][]string)
+	for _, component := range components {
+		dependencies[component.Name] = component.DependsOn
+	}
+
+	for _, component := range components {
+		for _, dependency := range component.DependsOn {
+			if dependency == component.Name {
+				return fmt.Errorf(lang.PkgValidateErrComponentDependsOnSelf, component.Name)
+			}
+			if _, ok := dependencies[dependency]; !ok {
+				return fmt.Errorf(lang.PkgValidateErrComponentDependsOn, component.Name, dependency)
+			}
+		}
+	}
+
+	// Walk the dependency graph depth first, a component seen again while it is still being visited closes a cycle
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[string]int)
+
+	var visit func(name string) error
+	visit = func(name string) error {
+		state[name] = visiting
+		for _, dependency := range dependencies[name] {
+			switch state[dependency] {
+			case visiting:
+				return fmt.Errorf(lang.PkgValidateErrComponentDependsOnCycle, name, dependency)
+			case unvisited:
+				if err := visit(dependency); err != nil {
+					return err
+				}
+			}
+		}
+		state[name] = visited
+		return nil
+	}
+
+	for _, component := range components {
+		if state[component.Name] == unvisited {
+			if err := visit(component.Name); err != nil {
+				return err
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -164,6 +219,12 @@ func validateComponent(pkg types.ZarfPackage, component types.ZarfComponent) err
 		}
 	}
 
+	for _, file := range component.Files {
+		if file.Template != "" && file.Template != types.GoTemplate {
+			return fmt.Errorf(lang.PkgValidateErrFileTemplate, file.Target, file.Template, types.GoTemplate)
+		}
+	}
+
 	if pkg.Metadata.YOLO {
 		if err := validateYOLO(component); err != nil {
 			return fmt.Errorf(lang.PkgValidateErrComponentYOLO, component.Name, err)
@@ -285,6 +346,16 @@ func validatePackageVariable(subject types.ZarfPackageVariable) error {
 		return fmt.Errorf(lang.PkgValidateMustBeUppercase, subject.Name)
 	}
 
+	if subject.Source != "" {
+		if _, err := variables.ParseSource(subject.Source); err != nil {
+			return fmt.Errorf(lang.PkgValidateErrVariableSource, subject.Name, err)
+		}
+	}
+
+	if err := variables.ValidateDefinition(subject); err != nil {
+		return fmt.Errorf(lang.PkgValidateErrVariableType, subject.Name, err)
+	}
+
 	return nil
 }
 
@@ -328,6 +399,12 @@ func validateChart(chart types.ZarfChart) error {
 		return fmt.Errorf(lang.PkgValidateErrChartVersion, chart.Name)
 	}
 
+	for _, variable := range chart.Variables {
+		if !IsUppercaseNumberUnderscore(variable.Name) || variable.Path == "" {
+			return fmt.Errorf(lang.PkgValidateErrChartVariable, chart.Name, variable.Name)
+		}
+	}
+
 	return nil
 }
 
@@ -347,5 +424,9 @@ func validateManifest(manifest types.ZarfManifest) error {
 		return fmt.Errorf(lang.PkgValidateErrManifestFileOrKustomize, manifest.Name)
 	}
 
+	if manifest.Template != "" && manifest.Template != types.GoTemplate {
+		return fmt.Errorf(lang.PkgValidateErrManifestTemplate, manifest.Name, manifest.Template, types.GoTemplate)
+	}
+
 	return nil
 }
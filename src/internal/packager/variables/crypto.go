@@ -0,0 +1,83 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package variables contains functions for resolving, validating and encrypting the values of package variables.
+package variables
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+const saltLength = 16
+
+// Encrypt encrypts the value of a sensitive variable with a key (passphrase) so it can be stored with a deployed package.
+// The result is the base64 encoded salt, nonce and AES-GCM ciphertext.
+func Encrypt(value, key string) (string, error) {
+	salt := make([]byte, saltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+
+	gcm, err := newGCM(key, salt)
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+
+	sealed := gcm.Seal(append(salt, nonce...), nonce, []byte(value), nil)
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt decrypts a variable value encrypted by Encrypt with the same key.
+func Decrypt(encrypted, key string) (string, error) {
+	sealed, err := base64.StdEncoding.DecodeString(encrypted)
+	if err != nil {
+		return "", fmt.Errorf("unable to decode the encrypted value: %w", err)
+	}
+	if len(sealed) < saltLength {
+		return "", errors.New("the encrypted value is too short")
+	}
+
+	gcm, err := newGCM(key, sealed[:saltLength])
+	if err != nil {
+		return "", err
+	}
+
+	sealed = sealed[saltLength:]
+	if len(sealed) < gcm.NonceSize() {
+		return "", errors.New("the encrypted value is too short")
+	}
+
+	value, err := gcm.Open(nil, sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():], nil)
+	if err != nil {
+		return "", errors.New("unable to decrypt the value, the key may be incorrect")
+	}
+
+	return string(value), nil
+}
+
+// newGCM derives an AES-256 key from the passphrase and salt and returns its GCM cipher.
+func newGCM(key string, salt []byte) (cipher.AEAD, error) {
+	derived, err := scrypt.Key([]byte(key), salt, 1<<15, 8, 1, 32)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(derived)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,37 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package variables contains functions for resolving, validating and encrypting the values of package variables.
+package variables
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncryptDecrypt(t *testing.T) {
+	t.Parallel()
+
+	encrypted, err := Encrypt("hunter2", "correct-key")
+	require.NoError(t, err)
+	require.NotContains(t, encrypted, "hunter2")
+
+	// Each encryption uses a fresh salt and nonce
+	again, err := Encrypt("hunter2", "correct-key")
+	require.NoError(t, err)
+	require.NotEqual(t, encrypted, again)
+
+	value, err := Decrypt(encrypted, "correct-key")
+	require.NoError(t, err)
+	require.Equal(t, "hunter2", value)
+
+	_, err = Decrypt(encrypted, "wrong-key")
+	require.Error(t, err)
+
+	_, err = Decrypt("bm90LWVuY3J5cHRlZA==", "correct-key")
+	require.Error(t, err)
+
+	_, err = Decrypt("not base64!", "correct-key")
+	require.Error(t, err)
+}
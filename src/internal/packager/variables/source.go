@@ -0,0 +1,192 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package variables contains functions for resolving, validating and encrypting the values of package variables.
+package variables
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// The schemes of the sources a package variable can be resolved from.
+const (
+	EnvScheme       = "env"
+	FileScheme      = "file"
+	K8sSecretScheme = "k8sSecret"
+	VaultScheme     = "vault"
+	VaultHTTPScheme = "vault+http"
+)
+
+const (
+	// VaultTokenEnv is the environment variable the token for Vault sources is read from.
+	VaultTokenEnv = "VAULT_TOKEN"
+	// VaultNamespaceEnv is the environment variable the (optional) namespace for Vault sources is read from.
+	VaultNamespaceEnv = "VAULT_NAMESPACE"
+)
+
+// vaultClient is the client used to read Vault sources.
+var vaultClient = &http.Client{Timeout: 30 * time.Second}
+
+// SecretGetter gets a K8s Secret for k8sSecret sources.
+type SecretGetter func(namespace, name string) (*corev1.Secret, error)
+
+// Source is an external source the value of a package variable is resolved from at deploy time, written as a URI:
+//
+//	env://NAME                           - the environment variable NAME
+//	file://path/to/file                  - the contents of a file
+//	k8sSecret://namespace/name#key       - a key of a K8s Secret
+//	vault://host:port/path/to/secret#key - a key of a Vault-compatible KV secret (vault+http:// for plain HTTP)
+type Source struct {
+	Scheme string
+	Path   string
+	Key    string
+}
+
+// ParseSource parses and validates a variable source URI.
+func ParseSource(source string) (Source, error) {
+	scheme, rest, found := strings.Cut(source, "://")
+	if !found {
+		return Source{}, fmt.Errorf("source %q must be of the form <scheme>://<path>", source)
+	}
+
+	path, key, _ := strings.Cut(rest, "#")
+	s := Source{Scheme: scheme, Path: path, Key: key}
+
+	if s.Path == "" {
+		return s, fmt.Errorf("source %q is missing a path", source)
+	}
+
+	switch s.Scheme {
+	case EnvScheme, FileScheme:
+		if s.Key != "" {
+			return s, fmt.Errorf("%s sources do not take a #key", s.Scheme)
+		}
+	case K8sSecretScheme:
+		if parts := strings.Split(s.Path, "/"); len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return s, fmt.Errorf("%s sources must be of the form %s://namespace/name#key", s.Scheme, s.Scheme)
+		}
+		if s.Key == "" {
+			return s, fmt.Errorf("%s sources must include a #key", s.Scheme)
+		}
+	case VaultScheme, VaultHTTPScheme:
+		if host, secretPath, _ := strings.Cut(s.Path, "/"); host == "" || secretPath == "" {
+			return s, fmt.Errorf("%s sources must be of the form %s://host/path/to/secret#key", s.Scheme, s.Scheme)
+		}
+		if s.Key == "" {
+			return s, fmt.Errorf("%s sources must include a #key", s.Scheme)
+		}
+	default:
+		return s, fmt.Errorf("unsupported source scheme %q, must be one of %s, %s, %s, %s or %s", s.Scheme, EnvScheme, FileScheme, K8sSecretScheme, VaultScheme, VaultHTTPScheme)
+	}
+
+	return s, nil
+}
+
+// Resolve reads the value of the source, getSecret is only called for k8sSecret sources.
+func (s Source) Resolve(getSecret SecretGetter) (string, error) {
+	switch s.Scheme {
+	case EnvScheme:
+		value, ok := os.LookupEnv(s.Path)
+		if !ok {
+			return "", fmt.Errorf("the environment variable %s is not set", s.Path)
+		}
+		return value, nil
+
+	case FileScheme:
+		contents, err := os.ReadFile(s.Path)
+		if err != nil {
+			return "", err
+		}
+		return string(contents), nil
+
+	case K8sSecretScheme:
+		namespace, name, _ := strings.Cut(s.Path, "/")
+		secret, err := getSecret(namespace, name)
+		if err != nil {
+			return "", fmt.Errorf("unable to get the secret %s/%s: %w", namespace, name, err)
+		}
+
+		if value, ok := secret.Data[s.Key]; ok {
+			return string(value), nil
+		}
+		if value, ok := secret.StringData[s.Key]; ok {
+			return value, nil
+		}
+		return "", fmt.Errorf("the secret %s/%s has no key %q", namespace, name, s.Key)
+
+	case VaultScheme, VaultHTTPScheme:
+		return s.resolveVault()
+	}
+
+	return "", fmt.Errorf("unsupported source scheme %q", s.Scheme)
+}
+
+// resolveVault reads a key of a secret from the KV secrets engine of a Vault-compatible server.
+// Both the v1 and v2 KV engines are supported, for v2 the path must include the data/ segment (i.e. secret/data/my-app).
+func (s Source) resolveVault() (string, error) {
+	protocol := "https"
+	if s.Scheme == VaultHTTPScheme {
+		protocol = "http"
+	}
+	host, secretPath, _ := strings.Cut(s.Path, "/")
+
+	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s://%s/v1/%s", protocol, host, secretPath), nil)
+	if err != nil {
+		return "", err
+	}
+	if token, ok := os.LookupEnv(VaultTokenEnv); ok {
+		req.Header.Set("X-Vault-Token", token)
+	}
+	if namespace, ok := os.LookupEnv(VaultNamespaceEnv); ok {
+		req.Header.Set("X-Vault-Namespace", namespace)
+	}
+
+	resp, err := vaultClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return "", fmt.Errorf("unable to read the secret %s from %s: %s %s", secretPath, host, resp.Status, strings.TrimSpace(string(body)))
+	}
+
+	var secret struct {
+		Data map[string]json.RawMessage `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&secret); err != nil {
+		return "", fmt.Errorf("unable to decode the secret %s from %s: %w", secretPath, host, err)
+	}
+
+	data := secret.Data
+	// KV v2 nests the secret's data (beside its metadata) within the response data
+	if nested, ok := data["data"]; ok {
+		if _, isKey := data[s.Key]; !isKey {
+			data = map[string]json.RawMessage{}
+			if err := json.Unmarshal(nested, &data); err != nil {
+				return "", fmt.Errorf("unable to decode the secret %s from %s: %w", secretPath, host, err)
+			}
+		}
+	}
+
+	raw, ok := data[s.Key]
+	if !ok {
+		return "", fmt.Errorf("the secret %s from %s has no key %q", secretPath, host, s.Key)
+	}
+
+	// Keys usually hold strings but any other JSON value is passed through as is
+	var value string
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return string(raw), nil
+	}
+	return value, nil
+}
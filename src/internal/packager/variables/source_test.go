@@ -0,0 +1,119 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package variables contains functions for resolving, validating and encrypting the values of package variables.
+package variables
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestParseSource(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		source    string
+		expected  Source
+		expectErr bool
+	}{
+		{source: "env://DB_PASSWORD", expected: Source{Scheme: EnvScheme, Path: "DB_PASSWORD"}},
+		{source: "file://./secrets/password.txt", expected: Source{Scheme: FileScheme, Path: "./secrets/password.txt"}},
+		{source: "file:///etc/zarf/password", expected: Source{Scheme: FileScheme, Path: "/etc/zarf/password"}},
+		{source: "k8sSecret://app/db#password", expected: Source{Scheme: K8sSecretScheme, Path: "app/db", Key: "password"}},
+		{source: "vault://vault.example.com:8200/secret/data/app#password", expected: Source{Scheme: VaultScheme, Path: "vault.example.com:8200/secret/data/app", Key: "password"}},
+		{source: "DB_PASSWORD", expectErr: true},
+		{source: "env://", expectErr: true},
+		{source: "env://DB_PASSWORD#key", expectErr: true},
+		{source: "k8sSecret://db#password", expectErr: true},
+		{source: "k8sSecret://app/db", expectErr: true},
+		{source: "vault://vault.example.com#password", expectErr: true},
+		{source: "s3://bucket/key", expectErr: true},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.source, func(t *testing.T) {
+			t.Parallel()
+
+			source, err := ParseSource(tc.source)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, source)
+		})
+	}
+}
+
+func TestResolve(t *testing.T) {
+	t.Setenv("ZARF_TEST_VARIABLE_SOURCE", "from-env")
+	t.Setenv(VaultTokenEnv, "test-token")
+
+	passwordFile := filepath.Join(t.TempDir(), "password")
+	require.NoError(t, os.WriteFile(passwordFile, []byte("from-file"), 0600))
+
+	getSecret := func(namespace, name string) (*corev1.Secret, error) {
+		if namespace != "app" || name != "db" {
+			return nil, fmt.Errorf("secret %s/%s not found", namespace, name)
+		}
+		return &corev1.Secret{Data: map[string][]byte{"password": []byte("from-secret")}}, nil
+	}
+
+	vault := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != "test-token" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		switch r.URL.Path {
+		case "/v1/kv/app":
+			fmt.Fprint(w, `{"data":{"password":"from-vault-v1","port":5432}}`)
+		case "/v1/secret/data/app":
+			fmt.Fprint(w, `{"data":{"data":{"password":"from-vault-v2"},"metadata":{"version":3}}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer vault.Close()
+	vaultHost := strings.TrimPrefix(vault.URL, "http://")
+
+	tests := []struct {
+		source    string
+		expected  string
+		expectErr bool
+	}{
+		{source: "env://ZARF_TEST_VARIABLE_SOURCE", expected: "from-env"},
+		{source: "env://ZARF_TEST_VARIABLE_SOURCE_UNSET", expectErr: true},
+		{source: "file://" + passwordFile, expected: "from-file"},
+		{source: "k8sSecret://app/db#password", expected: "from-secret"},
+		{source: "k8sSecret://app/db#username", expectErr: true},
+		{source: "k8sSecret://app/missing#password", expectErr: true},
+		{source: fmt.Sprintf("vault+http://%s/kv/app#password", vaultHost), expected: "from-vault-v1"},
+		{source: fmt.Sprintf("vault+http://%s/kv/app#port", vaultHost), expected: "5432"},
+		{source: fmt.Sprintf("vault+http://%s/secret/data/app#password", vaultHost), expected: "from-vault-v2"},
+		{source: fmt.Sprintf("vault+http://%s/secret/data/app#username", vaultHost), expectErr: true},
+		{source: fmt.Sprintf("vault+http://%s/secret/data/missing#password", vaultHost), expectErr: true},
+	}
+
+	for _, tc := range tests {
+		source, err := ParseSource(tc.source)
+		require.NoError(t, err, tc.source)
+
+		value, err := source.Resolve(getSecret)
+		if tc.expectErr {
+			require.Error(t, err, tc.source)
+			continue
+		}
+		require.NoError(t, err, tc.source)
+		require.Equal(t, tc.expected, value, tc.source)
+	}
+}
@@ -0,0 +1,172 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package variables contains functions for resolving, validating and encrypting the values of package variables.
+package variables
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/defenseunicorns/zarf/src/types"
+	"github.com/xeipuuv/gojsonschema"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/yaml"
+)
+
+// ValidateDefinition checks that the type specific fields of a package variable are consistent with its type.
+func ValidateDefinition(variable types.ZarfPackageVariable) error {
+	switch variable.Type {
+	case "", types.RawVariableType, types.FileVariableType, types.IntVariableType, types.BoolVariableType,
+		types.EnumVariableType, types.CIDRVariableType, types.HostnameVariableType, types.URLVariableType,
+		types.DurationVariableType, types.YAMLVariableType, types.JSONVariableType:
+	default:
+		return fmt.Errorf("unsupported type %q", variable.Type)
+	}
+
+	if (variable.Min != nil || variable.Max != nil) && variable.Type != types.IntVariableType {
+		return fmt.Errorf("min and max can only be used with the %q type", types.IntVariableType)
+	}
+	if variable.Min != nil && variable.Max != nil && *variable.Min > *variable.Max {
+		return fmt.Errorf("min %d is greater than max %d", *variable.Min, *variable.Max)
+	}
+
+	if variable.Type == types.EnumVariableType && len(variable.Choices) == 0 {
+		return fmt.Errorf("the %q type requires choices", types.EnumVariableType)
+	}
+	if len(variable.Choices) > 0 && variable.Type != types.EnumVariableType {
+		return fmt.Errorf("choices can only be used with the %q type", types.EnumVariableType)
+	}
+
+	if variable.Schema != "" {
+		if variable.Type != types.YAMLVariableType && variable.Type != types.JSONVariableType {
+			return fmt.Errorf("schema can only be used with the %q and %q types", types.YAMLVariableType, types.JSONVariableType)
+		}
+		if _, err := loadSchema(variable.Schema); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Validate checks that a value is valid for the type of a package variable, empty values are left for a pattern to require.
+// The errors of sensitive variables are generic since parse and schema errors can include parts of the value.
+func Validate(variable types.ZarfPackageVariable, value string) error {
+	err := validateValue(variable, value)
+	if err != nil && variable.Sensitive {
+		return fmt.Errorf("value is not a valid %s (details are hidden for sensitive variables)", variable.Type)
+	}
+	return err
+}
+
+// validateValue checks that a value is valid for the type of a package variable.
+func validateValue(variable types.ZarfPackageVariable, value string) error {
+	if value == "" {
+		return nil
+	}
+
+	switch variable.Type {
+	case types.IntVariableType:
+		i, err := strconv.Atoi(value)
+		if err != nil {
+			return errors.New("value is not an integer")
+		}
+		if variable.Min != nil && i < *variable.Min {
+			return fmt.Errorf("value is less than the minimum of %d", *variable.Min)
+		}
+		if variable.Max != nil && i > *variable.Max {
+			return fmt.Errorf("value is greater than the maximum of %d", *variable.Max)
+		}
+
+	case types.BoolVariableType:
+		if _, err := strconv.ParseBool(value); err != nil {
+			return errors.New("value is not a boolean")
+		}
+
+	case types.EnumVariableType:
+		if !slices.Contains(variable.Choices, value) {
+			return fmt.Errorf("value is not one of %s", strings.Join(variable.Choices, ", "))
+		}
+
+	case types.CIDRVariableType:
+		if _, _, err := net.ParseCIDR(value); err != nil {
+			return errors.New("value is not a CIDR")
+		}
+
+	case types.HostnameVariableType:
+		if errs := validation.IsDNS1123Subdomain(value); len(errs) > 0 {
+			return fmt.Errorf("value is not a hostname: %s", strings.Join(errs, ", "))
+		}
+
+	case types.URLVariableType:
+		if u, err := url.ParseRequestURI(value); err != nil || u.Scheme == "" || u.Host == "" {
+			return errors.New("value is not an absolute URL")
+		}
+
+	case types.DurationVariableType:
+		if _, err := time.ParseDuration(value); err != nil {
+			return errors.New("value is not a duration")
+		}
+
+	case types.YAMLVariableType, types.JSONVariableType:
+		var document any
+		if variable.Type == types.JSONVariableType {
+			if err := json.Unmarshal([]byte(value), &document); err != nil {
+				return fmt.Errorf("value is not JSON: %w", err)
+			}
+		} else if err := yaml.Unmarshal([]byte(value), &document); err != nil {
+			return fmt.Errorf("value is not YAML: %w", err)
+		}
+		if variable.Schema != "" {
+			return validateSchema(variable.Schema, document)
+		}
+	}
+
+	return nil
+}
+
+// loadSchema parses an inline JSON schema that may be written as JSON or YAML.
+func loadSchema(schema string) (*gojsonschema.Schema, error) {
+	var raw any
+	if err := yaml.Unmarshal([]byte(schema), &raw); err != nil {
+		return nil, fmt.Errorf("schema is not JSON or YAML: %w", err)
+	}
+
+	loaded, err := gojsonschema.NewSchema(gojsonschema.NewGoLoader(raw))
+	if err != nil {
+		return nil, fmt.Errorf("schema is not a valid JSON schema: %w", err)
+	}
+
+	return loaded, nil
+}
+
+// validateSchema checks a document against an inline JSON schema.
+func validateSchema(schema string, document any) error {
+	loaded, err := loadSchema(schema)
+	if err != nil {
+		return err
+	}
+
+	result, err := loaded.Validate(gojsonschema.NewGoLoader(document))
+	if err != nil {
+		return err
+	}
+
+	if !result.Valid() {
+		errs := []string{}
+		for _, desc := range result.Errors() {
+			errs = append(errs, desc.String())
+		}
+		return errors.New(strings.Join(errs, ", "))
+	}
+
+	return nil
+}
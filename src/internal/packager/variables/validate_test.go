@@ -0,0 +1,137 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package variables contains functions for resolving, validating and encrypting the values of package variables.
+package variables
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/defenseunicorns/zarf/src/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	one, ten := 1, 10
+	schema := `{"type": "object", "required": ["host"], "properties": {"port": {"type": "integer"}}}`
+
+	tests := []struct {
+		name      string
+		variable  types.ZarfPackageVariable
+		value     string
+		expectErr bool
+	}{
+		{name: "raw", variable: types.ZarfPackageVariable{}, value: "anything"},
+		{name: "empty", variable: types.ZarfPackageVariable{Type: types.IntVariableType}, value: ""},
+		{name: "int", variable: types.ZarfPackageVariable{Type: types.IntVariableType, Min: &one, Max: &ten}, value: "5"},
+		{name: "int not a number", variable: types.ZarfPackageVariable{Type: types.IntVariableType}, value: "five", expectErr: true},
+		{name: "int below min", variable: types.ZarfPackageVariable{Type: types.IntVariableType, Min: &one}, value: "0", expectErr: true},
+		{name: "int above max", variable: types.ZarfPackageVariable{Type: types.IntVariableType, Max: &ten}, value: "11", expectErr: true},
+		{name: "bool", variable: types.ZarfPackageVariable{Type: types.BoolVariableType}, value: "true"},
+		{name: "bool invalid", variable: types.ZarfPackageVariable{Type: types.BoolVariableType}, value: "yes", expectErr: true},
+		{name: "enum", variable: types.ZarfPackageVariable{Type: types.EnumVariableType, Choices: []string{"debug", "info"}}, value: "info"},
+		{name: "enum invalid", variable: types.ZarfPackageVariable{Type: types.EnumVariableType, Choices: []string{"debug", "info"}}, value: "trace", expectErr: true},
+		{name: "cidr", variable: types.ZarfPackageVariable{Type: types.CIDRVariableType}, value: "10.0.0.0/16"},
+		{name: "cidr invalid", variable: types.ZarfPackageVariable{Type: types.CIDRVariableType}, value: "10.0.0.0", expectErr: true},
+		{name: "hostname", variable: types.ZarfPackageVariable{Type: types.HostnameVariableType}, value: "app.example.com"},
+		{name: "hostname invalid", variable: types.ZarfPackageVariable{Type: types.HostnameVariableType}, value: "app_example.com", expectErr: true},
+		{name: "url", variable: types.ZarfPackageVariable{Type: types.URLVariableType}, value: "https://example.com/path"},
+		{name: "url invalid", variable: types.ZarfPackageVariable{Type: types.URLVariableType}, value: "example.com", expectErr: true},
+		{name: "duration", variable: types.ZarfPackageVariable{Type: types.DurationVariableType}, value: "1h30m"},
+		{name: "duration invalid", variable: types.ZarfPackageVariable{Type: types.DurationVariableType}, value: "90", expectErr: true},
+		{name: "yaml", variable: types.ZarfPackageVariable{Type: types.YAMLVariableType, Schema: schema}, value: "host: example.com\nport: 443"},
+		{name: "yaml schema mismatch", variable: types.ZarfPackageVariable{Type: types.YAMLVariableType, Schema: schema}, value: "port: https", expectErr: true},
+		{name: "yaml invalid", variable: types.ZarfPackageVariable{Type: types.YAMLVariableType}, value: "a: [", expectErr: true},
+		{name: "json", variable: types.ZarfPackageVariable{Type: types.JSONVariableType, Schema: schema}, value: `{"host": "example.com"}`},
+		{name: "json is not yaml", variable: types.ZarfPackageVariable{Type: types.JSONVariableType}, value: "host: example.com", expectErr: true},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := Validate(tc.variable, tc.value)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateSensitive(t *testing.T) {
+	t.Parallel()
+
+	closedSchema := `{"type": "object", "additionalProperties": false}`
+
+	tests := []struct {
+		name     string
+		variable types.ZarfPackageVariable
+		value    string
+	}{
+		{name: "int", variable: types.ZarfPackageVariable{Type: types.IntVariableType}, value: "hunter2"},
+		{name: "hostname", variable: types.ZarfPackageVariable{Type: types.HostnameVariableType}, value: "hunter2_"},
+		{name: "json invalid", variable: types.ZarfPackageVariable{Type: types.JSONVariableType}, value: `{"password": hunter2}`},
+		{name: "yaml invalid", variable: types.ZarfPackageVariable{Type: types.YAMLVariableType}, value: "password: [hunter2"},
+		{name: "schema mismatch", variable: types.ZarfPackageVariable{Type: types.JSONVariableType, Schema: closedSchema}, value: `{"hunter2": true}`},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			tc.variable.Sensitive = true
+			err := Validate(tc.variable, tc.value)
+			require.EqualError(t, err, fmt.Sprintf("value is not a valid %s (details are hidden for sensitive variables)", tc.variable.Type))
+			require.Nil(t, errors.Unwrap(err))
+		})
+	}
+
+	// The details are kept for variables that are not sensitive
+	err := Validate(types.ZarfPackageVariable{Type: types.JSONVariableType, Schema: closedSchema}, `{"hunter2": true}`)
+	require.ErrorContains(t, err, "hunter2")
+}
+
+func TestValidateDefinition(t *testing.T) {
+	t.Parallel()
+
+	one, ten := 1, 10
+
+	tests := []struct {
+		name      string
+		variable  types.ZarfPackageVariable
+		expectErr bool
+	}{
+		{name: "untyped", variable: types.ZarfPackageVariable{}},
+		{name: "int range", variable: types.ZarfPackageVariable{Type: types.IntVariableType, Min: &one, Max: &ten}},
+		{name: "unsupported type", variable: types.ZarfPackageVariable{Type: "float"}, expectErr: true},
+		{name: "min above max", variable: types.ZarfPackageVariable{Type: types.IntVariableType, Min: &ten, Max: &one}, expectErr: true},
+		{name: "min on a non int", variable: types.ZarfPackageVariable{Min: &one}, expectErr: true},
+		{name: "enum without choices", variable: types.ZarfPackageVariable{Type: types.EnumVariableType}, expectErr: true},
+		{name: "choices on a non enum", variable: types.ZarfPackageVariable{Choices: []string{"a"}}, expectErr: true},
+		{name: "yaml schema", variable: types.ZarfPackageVariable{Type: types.YAMLVariableType, Schema: "type: object"}},
+		{name: "invalid schema", variable: types.ZarfPackageVariable{Type: types.JSONVariableType, Schema: `{"type": 5}`}, expectErr: true},
+		{name: "schema on a non structured type", variable: types.ZarfPackageVariable{Schema: "type: object"}, expectErr: true},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := ValidateDefinition(tc.variable)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,258 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package bundle contains functions for deploying several Zarf packages together from a bundle file.
+package bundle
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/defenseunicorns/zarf/src/config"
+	"github.com/defenseunicorns/zarf/src/config/lang"
+	"github.com/defenseunicorns/zarf/src/pkg/message"
+	"github.com/defenseunicorns/zarf/src/pkg/packager"
+	"github.com/defenseunicorns/zarf/src/pkg/utils"
+	"github.com/defenseunicorns/zarf/src/pkg/utils/helpers"
+	"github.com/defenseunicorns/zarf/src/types"
+	"github.com/pterm/pterm"
+)
+
+const (
+	statusDeployed = "Deployed"
+	statusFailed   = "Failed"
+	statusSkipped  = "Skipped"
+)
+
+// Read reads a bundle file, resolving the local paths in it relative to the bundle file.
+func Read(bundlePath string) (*types.ZarfBundle, error) {
+	var bundle types.ZarfBundle
+	if err := utils.ReadYaml(bundlePath, &bundle); err != nil {
+		return nil, fmt.Errorf("unable to read the bundle file %s: %w", bundlePath, err)
+	}
+
+	bundleDir := filepath.Dir(bundlePath)
+	for idx, pkg := range bundle.Packages {
+		if pkg.Source != "" && !helpers.IsURL(pkg.Source) && !filepath.IsAbs(pkg.Source) {
+			bundle.Packages[idx].Source = filepath.Join(bundleDir, pkg.Source)
+		}
+		if pkg.PublicKey != "" && !filepath.IsAbs(pkg.PublicKey) {
+			bundle.Packages[idx].PublicKey = filepath.Join(bundleDir, pkg.PublicKey)
+		}
+	}
+
+	return &bundle, nil
+}
+
+// Validate checks that a bundle has uniquely named packages with sources and that their dependencies can be met.
+func Validate(bundle *types.ZarfBundle) error {
+	if bundle.Kind != types.ZarfBundleConfig {
+		return fmt.Errorf(lang.BundleErrKind, bundle.Kind, types.ZarfBundleConfig)
+	}
+
+	if len(bundle.Packages) == 0 {
+		return errors.New(lang.BundleErrNoPackages)
+	}
+
+	names := map[string]bool{}
+	for _, pkg := range bundle.Packages {
+		if pkg.Name == "" {
+			return fmt.Errorf(lang.BundleErrPackageName, pkg.Source)
+		}
+		if names[pkg.Name] {
+			return fmt.Errorf(lang.BundleErrPackageNameNotUnique, pkg.Name)
+		}
+		if pkg.Source == "" {
+			return fmt.Errorf(lang.BundleErrPackageSource, pkg.Name)
+		}
+		names[pkg.Name] = true
+	}
+
+	for _, pkg := range bundle.Packages {
+		for _, dependency := range pkg.DependsOn {
+			if !names[dependency] {
+				return fmt.Errorf(lang.BundleErrDependsOn, pkg.Name, dependency)
+			}
+		}
+	}
+
+	_, err := getDeploymentOrder(bundle.Packages)
+	return err
+}
+
+// Deploy deploys the packages of a bundle one at a time in dependency order after a single confirmation.
+// Deployment stops at the first package that fails and a summary of every package is printed either way.
+func Deploy(bundle *types.ZarfBundle, deployOpts types.ZarfDeployOptions, setVariables map[string]string) error {
+	if err := Validate(bundle); err != nil {
+		return err
+	}
+
+	packages, err := getDeploymentOrder(bundle.Packages)
+	if err != nil {
+		return err
+	}
+
+	if !confirmDeploy(bundle, packages) {
+		return fmt.Errorf("bundle deployment cancelled")
+	}
+
+	statuses := make(map[string]string, len(packages))
+	var deployErr error
+
+	for _, pkg := range packages {
+		if deployErr != nil {
+			statuses[pkg.Name] = statusSkipped
+			continue
+		}
+
+		message.HeaderInfof("📦 BUNDLE PACKAGE %s", strings.ToUpper(pkg.Name))
+
+		if err := deployPackage(pkg, deployOpts, setVariables); err != nil {
+			statuses[pkg.Name] = statusFailed
+			deployErr = fmt.Errorf("unable to deploy the bundle package %q: %w", pkg.Name, err)
+			continue
+		}
+
+		statuses[pkg.Name] = statusDeployed
+	}
+
+	printSummary(packages, statuses)
+
+	return deployErr
+}
+
+// deployPackage deploys a single bundle package with its own packager.
+func deployPackage(pkg types.ZarfBundlePackage, deployOpts types.ZarfDeployOptions, setVariables map[string]string) error {
+	pkgConfig := types.PackagerConfig{
+		PkgOpts: types.ZarfPackageOptions{
+			PackageSource:      pkg.Source,
+			Shasum:             pkg.Shasum,
+			PublicKeyPath:      pkg.PublicKey,
+			OptionalComponents: strings.Join(pkg.Components, ","),
+			// Variables provided on the command line take precedence over the ones in the bundle
+			SetVariables: helpers.TransformAndMergeMap(pkg.Variables, setVariables, strings.ToUpper),
+		},
+		DeployOpts: deployOpts,
+	}
+
+	// Every package was confirmed together so do not prompt to confirm each one
+	pkgClient, err := packager.New(&pkgConfig, packager.WithConfirmed())
+	if err != nil {
+		return err
+	}
+	defer pkgClient.ClearTempPaths()
+
+	return pkgClient.Deploy()
+}
+
+// getDeploymentOrder returns the bundle packages ordered so that every package comes after the packages it depends on.
+// Packages keep their order in the bundle file whenever their dependencies allow it.
+func getDeploymentOrder(packages []types.ZarfBundlePackage) ([]types.ZarfBundlePackage, error) {
+	ordered := []types.ZarfBundlePackage{}
+	deployed := map[string]bool{}
+
+	for len(ordered) < len(packages) {
+		progressed := false
+
+		for _, pkg := range packages {
+			if deployed[pkg.Name] {
+				continue
+			}
+
+			ready := true
+			for _, dependency := range pkg.DependsOn {
+				if !deployed[dependency] && dependency != pkg.Name {
+					ready = false
+					break
+				}
+			}
+			if !ready {
+				continue
+			}
+
+			ordered = append(ordered, pkg)
+			deployed[pkg.Name] = true
+			progressed = true
+
+			// Restart from the top so earlier packages that were waiting on this one keep their place
+			break
+		}
+
+		if !progressed {
+			blocked := []string{}
+			for _, pkg := range packages {
+				if !deployed[pkg.Name] {
+					blocked = append(blocked, pkg.Name)
+				}
+			}
+			return nil, fmt.Errorf(lang.BundleErrDependsOnCycle, strings.Join(blocked, ", "))
+		}
+	}
+
+	return ordered, nil
+}
+
+// confirmDeploy prints the packages a bundle deploys and asks for a single confirmation unless --confirm is set.
+func confirmDeploy(bundle *types.ZarfBundle, packages []types.ZarfBundlePackage) (confirm bool) {
+	pterm.Println()
+	message.HeaderInfof("📦 BUNDLE DEFINITION")
+
+	if bundle.Metadata.Name != "" {
+		message.Title(bundle.Metadata.Name, bundle.Metadata.Description)
+	}
+
+	packageData := [][]string{}
+	for idx, pkg := range packages {
+		components := strings.Join(pkg.Components, ", ")
+		if components == "" {
+			components = "(defaults)"
+		}
+
+		variables := []string{}
+		for name := range helpers.TransformMapKeys(pkg.Variables, strings.ToUpper) {
+			variables = append(variables, name)
+		}
+		slices.Sort(variables)
+
+		packageData = append(packageData, []string{strconv.Itoa(idx + 1), pkg.Name, pkg.Source, components, strings.Join(variables, ", ")})
+	}
+	message.Table([]string{"Order", "Package", "Source", "Components", "Variables"}, packageData)
+
+	message.HorizontalRule()
+
+	if config.CommonOptions.Confirm {
+		pterm.Println()
+		message.Successf("Deploy Zarf bundle confirmed")
+		return true
+	}
+
+	prompt := &survey.Confirm{
+		Message: fmt.Sprintf("Deploy these %d Zarf packages?", len(packages)),
+	}
+
+	pterm.Println()
+
+	// Prompt the user for confirmation, on abort return false
+	if err := survey.AskOne(prompt, &confirm); err != nil || !confirm {
+		return false
+	}
+
+	return true
+}
+
+// printSummary prints the outcome of every package of a bundle deployment.
+func printSummary(packages []types.ZarfBundlePackage, statuses map[string]string) {
+	message.HorizontalRule()
+	message.Title("Bundle Summary", "the outcome of each package in this bundle")
+
+	summaryData := [][]string{}
+	for _, pkg := range packages {
+		summaryData = append(summaryData, []string{pkg.Name, pkg.Source, statuses[pkg.Name]})
+	}
+	message.Table([]string{"Package", "Source", "Status"}, summaryData)
+}
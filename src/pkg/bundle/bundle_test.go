@@ -0,0 +1,146 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package bundle contains functions for deploying several Zarf packages together from a bundle file.
+package bundle
+
+import (
+	"testing"
+
+	"github.com/defenseunicorns/zarf/src/types"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGetDeploymentOrder verifies that bundle packages deploy after their dependencies and otherwise keep their order.
+func TestGetDeploymentOrder(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name        string
+		packages    []types.ZarfBundlePackage
+		expected    []string
+		expectedErr string
+	}
+
+	testCases := []testCase{
+		{
+			name: "no dependencies keeps the file order",
+			packages: []types.ZarfBundlePackage{
+				{Name: "a"}, {Name: "b"}, {Name: "c"},
+			},
+			expected: []string{"a", "b", "c"},
+		},
+		{
+			name: "dependencies move later packages ahead",
+			packages: []types.ZarfBundlePackage{
+				{Name: "app", DependsOn: []string{"database"}},
+				{Name: "monitoring"},
+				{Name: "database"},
+			},
+			expected: []string{"monitoring", "database", "app"},
+		},
+		{
+			name: "waiting packages keep their place once unblocked",
+			packages: []types.ZarfBundlePackage{
+				{Name: "a", DependsOn: []string{"c"}},
+				{Name: "b", DependsOn: []string{"c"}},
+				{Name: "c"},
+			},
+			expected: []string{"c", "a", "b"},
+		},
+		{
+			name: "cycles are reported",
+			packages: []types.ZarfBundlePackage{
+				{Name: "ok"},
+				{Name: "a", DependsOn: []string{"b"}},
+				{Name: "b", DependsOn: []string{"a"}},
+			},
+			expectedErr: "a, b",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ordered, err := getDeploymentOrder(tc.packages)
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			names := []string{}
+			for _, pkg := range ordered {
+				names = append(names, pkg.Name)
+			}
+			require.Equal(t, tc.expected, names)
+		})
+	}
+}
+
+// TestValidate verifies that invalid bundle files are rejected before anything deploys.
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name     string
+		bundle   types.ZarfBundle
+		expected bool
+	}
+
+	testCases := []testCase{
+		{
+			name: "valid bundle",
+			bundle: types.ZarfBundle{Kind: types.ZarfBundleConfig, Packages: []types.ZarfBundlePackage{
+				{Name: "a", Source: "a.tar.zst"},
+				{Name: "b", Source: "oci://example.com/b:1.0.0", DependsOn: []string{"a"}},
+			}},
+			expected: true,
+		},
+		{
+			name: "wrong kind",
+			bundle: types.ZarfBundle{Kind: "ZarfPackageConfig", Packages: []types.ZarfBundlePackage{
+				{Name: "a", Source: "a.tar.zst"},
+			}},
+		},
+		{
+			name:   "no packages",
+			bundle: types.ZarfBundle{Kind: types.ZarfBundleConfig},
+		},
+		{
+			name: "duplicate names",
+			bundle: types.ZarfBundle{Kind: types.ZarfBundleConfig, Packages: []types.ZarfBundlePackage{
+				{Name: "a", Source: "a.tar.zst"},
+				{Name: "a", Source: "b.tar.zst"},
+			}},
+		},
+		{
+			name: "missing source",
+			bundle: types.ZarfBundle{Kind: types.ZarfBundleConfig, Packages: []types.ZarfBundlePackage{
+				{Name: "a"},
+			}},
+		},
+		{
+			name: "unknown dependency",
+			bundle: types.ZarfBundle{Kind: types.ZarfBundleConfig, Packages: []types.ZarfBundlePackage{
+				{Name: "a", Source: "a.tar.zst", DependsOn: []string{"missing"}},
+			}},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := Validate(&tc.bundle)
+			if tc.expected {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
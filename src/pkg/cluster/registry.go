@@ -0,0 +1,392 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package cluster contains Zarf-specific cluster management functions.
+package cluster
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/defenseunicorns/zarf/src/config"
+	"github.com/defenseunicorns/zarf/src/config/lang"
+	"github.com/defenseunicorns/zarf/src/pkg/message"
+	"github.com/defenseunicorns/zarf/src/pkg/transform"
+	"github.com/defenseunicorns/zarf/src/types"
+	"github.com/google/go-containerregistry/pkg/crane"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+	ocitypes "github.com/google/go-containerregistry/pkg/v1/types"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// ZarfSBOMRepository is the repository in the Zarf registry that the SBOMs of deployed packages are stored under.
+const ZarfSBOMRepository = "zarf-sboms"
+
+var invalidTagRegex = regexp.MustCompile(`[^a-zA-Z0-9_.\-]`)
+
+// cosignTagSuffixes are the tag suffixes cosign uses to attach signatures, attestations and SBOMs to an image digest.
+var cosignTagSuffixes = []string{".sig", ".att", ".sbom"}
+
+// RegistryGarbage is the set of manifests in the Zarf registry that nothing in the cluster references.
+type RegistryGarbage struct {
+	// References are the digest references of the unreferenced manifests
+	References []string
+	// ReclaimableBytes is the size of the blobs only the unreferenced manifests use
+	ReclaimableBytes int64
+}
+
+// FindRegistryGarbage finds the manifests in the Zarf registry that no deployed package and no pod in the cluster references.
+// Only the repositories that the images of deployed packages are pushed to are pruned, so the SBOMs of deployed packages and
+// images pushed outside of a deployment (i.e. with 'zarf package mirror-resources') are never collected.
+// The registryEndpoint is the address the registry is reachable at from this machine, usually through a tunnel.
+func (c *Cluster) FindRegistryGarbage(registryInfo types.RegistryInfo, registryEndpoint string) (*RegistryGarbage, error) {
+	spinner := message.NewProgressSpinner("Finding unreferenced images in the Zarf registry")
+	defer spinner.Stop()
+
+	authOption := config.GetCraneAuthOption(registryInfo.PushUsername, registryInfo.PushPassword)
+
+	deployedPackages, errs := c.GetDeployedZarfPackages()
+	if len(errs) > 0 {
+		return nil, lang.ErrUnableToGetPackages
+	}
+
+	spinner.Updatef("Finding the images referenced by %d deployed packages", len(deployedPackages))
+	referenced, err := getPackageImageDigests(deployedPackages, registryEndpoint, authOption)
+	if err != nil {
+		return nil, err
+	}
+
+	packageRepositories, err := getPackageRepositories(deployedPackages)
+	if err != nil {
+		return nil, err
+	}
+
+	spinner.Updatef("Finding the images referenced by pods in the cluster")
+	pods, err := c.GetAllPods()
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the pods in the cluster: %w", err)
+	}
+	podDigests, err := getPodImageDigests(pods.Items, registryInfo.Address, registryEndpoint, authOption)
+	if err != nil {
+		return nil, err
+	}
+	for digest := range podDigests {
+		referenced[digest] = true
+	}
+
+	spinner.Updatef("Listing the images in the Zarf registry")
+	catalog, err := crane.Catalog(registryEndpoint, authOption)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list the repositories in the Zarf registry: %w", err)
+	}
+
+	tagDigests := map[string]string{}
+	for _, repository := range catalog {
+		prunable := isPrunableRepository(repository, packageRepositories)
+		repositoryRef := fmt.Sprintf("%s/%s", registryEndpoint, repository)
+		tags, err := crane.ListTags(repositoryRef, authOption)
+		if err != nil {
+			return nil, fmt.Errorf("unable to list the tags of %s: %w", repositoryRef, err)
+		}
+		for _, tag := range tags {
+			taggedRef := fmt.Sprintf("%s:%s", repositoryRef, tag)
+			digest, err := crane.Digest(taggedRef, authOption)
+			if err != nil {
+				return nil, fmt.Errorf("unable to get the digest of %s: %w", taggedRef, err)
+			}
+			tagDigests[taggedRef] = digest
+
+			// Manifests outside of the pruned repositories are kept so that the blobs they share are not counted as reclaimable
+			if !prunable {
+				referenced[digest] = true
+			}
+		}
+	}
+
+	unreferenced, kept := splitUnreferencedManifests(tagDigests, referenced)
+
+	spinner.Updatef("Calculating the space used by %d unreferenced images", len(unreferenced))
+	unreferencedBlobs := map[string]int64{}
+	for _, digestRef := range unreferenced {
+		if err := addManifestBlobs(digestRef, unreferencedBlobs, authOption); err != nil {
+			return nil, err
+		}
+	}
+	keptBlobs := map[string]int64{}
+	for _, digestRef := range kept {
+		if err := addManifestBlobs(digestRef, keptBlobs, authOption); err != nil {
+			return nil, err
+		}
+	}
+
+	spinner.Successf("Found %d unreferenced images in the Zarf registry", len(unreferenced))
+
+	return &RegistryGarbage{
+		References:       unreferenced,
+		ReclaimableBytes: reclaimableBytes(unreferencedBlobs, keptBlobs),
+	}, nil
+}
+
+// DeleteRegistryGarbage deletes the unreferenced manifests from the Zarf registry through the registry API.
+// The registry frees the blobs of the deleted manifests the next time it garbage collects its storage.
+func DeleteRegistryGarbage(registryInfo types.RegistryInfo, garbage *RegistryGarbage) error {
+	authOption := config.GetCraneAuthOption(registryInfo.PushUsername, registryInfo.PushPassword)
+
+	spinner := message.NewProgressSpinner("Deleting %d unreferenced images from the Zarf registry", len(garbage.References))
+	defer spinner.Stop()
+
+	for _, digestRef := range garbage.References {
+		spinner.Updatef("Deleting %s", digestRef)
+		if err := crane.Delete(digestRef, authOption); err != nil && !isNotFound(err) {
+			return fmt.Errorf("unable to delete %s: %w", digestRef, err)
+		}
+	}
+
+	spinner.Successf("Deleted %d unreferenced images from the Zarf registry", len(garbage.References))
+
+	return nil
+}
+
+// getPackageImageDigests returns the digests of the images of every deployed component of the given packages.
+func getPackageImageDigests(deployedPackages []types.DeployedPackage, registryEndpoint string, authOption crane.Option) (map[string]bool, error) {
+	digests := map[string]bool{}
+
+	for _, deployedPackage := range deployedPackages {
+		deployedComponents := map[string]bool{}
+		for _, deployedComponent := range deployedPackage.DeployedComponents {
+			deployedComponents[deployedComponent.Name] = true
+		}
+
+		for _, component := range deployedPackage.Data.Components {
+			if !deployedComponents[component.Name] {
+				continue
+			}
+
+			for _, image := range component.Images {
+				// We use the no checksum image since it will always exist and will share the same digest with other tags
+				offlineName, err := transform.ImageTransformHostWithoutChecksum(registryEndpoint, image)
+				if err != nil {
+					return nil, err
+				}
+
+				digest, err := crane.Digest(offlineName, authOption)
+				if isNotFound(err) {
+					message.Debugf("Image %s of package %s is not in the Zarf registry", image, deployedPackage.Name)
+					continue
+				} else if err != nil {
+					return nil, fmt.Errorf("unable to get the digest of %s: %w", offlineName, err)
+				}
+				digests[digest] = true
+			}
+		}
+	}
+
+	return digests, nil
+}
+
+// getPackageRepositories returns the repositories in the Zarf registry that the images of the given packages are pushed to.
+// Components that are not deployed are included so that the images of removed components are still pruned.
+func getPackageRepositories(deployedPackages []types.DeployedPackage) (map[string]bool, error) {
+	repositories := map[string]bool{}
+
+	for _, deployedPackage := range deployedPackages {
+		for _, component := range deployedPackage.Data.Components {
+			for _, image := range component.Images {
+				refInfo, err := transform.ParseImageRef(image)
+				if err != nil {
+					return nil, err
+				}
+				repositories[refInfo.Path] = true
+			}
+		}
+	}
+
+	return repositories, nil
+}
+
+// isPrunableRepository returns whether the manifests of a repository in the Zarf registry can be pruned.
+func isPrunableRepository(repository string, packageRepositories map[string]bool) bool {
+	if repository == ZarfSBOMRepository || strings.HasPrefix(repository, ZarfSBOMRepository+"/") {
+		return false
+	}
+	return packageRepositories[repository]
+}
+
+// PackageSBOMReference returns where the SBOMs of a deployed package are stored in the Zarf registry at registryURL.
+// Each version of a package has its own tag so that redeploying a version replaces its SBOMs.
+func PackageSBOMReference(registryURL string, pkg types.ZarfPackage) string {
+	tag := "latest"
+	if pkg.Metadata.Version != "" {
+		tag = invalidTagRegex.ReplaceAllString(pkg.Metadata.Version, "_")
+	}
+
+	return fmt.Sprintf("%s/%s/%s:%s", registryURL, ZarfSBOMRepository, pkg.Metadata.Name, tag)
+}
+
+// getPodImageDigests returns the digests of the images that pods use from the Zarf registry at registryAddress.
+func getPodImageDigests(pods []corev1.Pod, registryAddress, registryEndpoint string, authOption crane.Option) (map[string]bool, error) {
+	digests := map[string]bool{}
+	registryPrefix := strings.TrimSuffix(registryAddress, "/") + "/"
+
+	for _, pod := range pods {
+		images := []string{}
+		for _, container := range pod.Spec.InitContainers {
+			images = append(images, container.Image)
+		}
+		for _, container := range pod.Spec.Containers {
+			images = append(images, container.Image)
+		}
+		for _, container := range pod.Spec.EphemeralContainers {
+			images = append(images, container.Image)
+		}
+
+		// The image IDs of started containers pin the digest even if a tag has since moved
+		statuses := []corev1.ContainerStatus{}
+		statuses = append(statuses, pod.Status.InitContainerStatuses...)
+		statuses = append(statuses, pod.Status.ContainerStatuses...)
+		statuses = append(statuses, pod.Status.EphemeralContainerStatuses...)
+		for _, status := range statuses {
+			if strings.HasPrefix(status.Image, registryPrefix) {
+				if _, digest, ok := strings.Cut(status.ImageID, "@"); ok {
+					digests[digest] = true
+				}
+			}
+		}
+
+		for _, image := range images {
+			if !strings.HasPrefix(image, registryPrefix) {
+				continue
+			}
+
+			if _, digest, ok := strings.Cut(image, "@"); ok {
+				digests[digest] = true
+				continue
+			}
+
+			endpointRef := registryEndpoint + "/" + strings.TrimPrefix(image, registryPrefix)
+			digest, err := crane.Digest(endpointRef, authOption)
+			if isNotFound(err) {
+				message.Debugf("Image %s of pod %s/%s is not in the Zarf registry", image, pod.Namespace, pod.Name)
+				continue
+			} else if err != nil {
+				return nil, fmt.Errorf("unable to get the digest of %s: %w", endpointRef, err)
+			}
+			digests[digest] = true
+		}
+	}
+
+	return digests, nil
+}
+
+// splitUnreferencedManifests splits the manifests the tags in the registry point to into the unreferenced and the kept digest references.
+// Cosign signature, attestation and SBOM tags are kept as long as the digest they are attached to is referenced.
+func splitUnreferencedManifests(tagDigests map[string]string, referenced map[string]bool) (unreferenced, kept []string) {
+	unreferencedRefs := map[string]bool{}
+	keptRefs := map[string]bool{}
+
+	for taggedRef, digest := range tagDigests {
+		// Split on the last colon so registry ports are kept with the repository name
+		tagIdx := strings.LastIndex(taggedRef, ":")
+		name, tag := taggedRef[:tagIdx], taggedRef[tagIdx+1:]
+		digestRef := fmt.Sprintf("%s@%s", name, digest)
+
+		if referenced[digest] || referenced[cosignSubjectDigest(tag)] {
+			keptRefs[digestRef] = true
+		} else {
+			unreferencedRefs[digestRef] = true
+		}
+	}
+
+	for digestRef := range unreferencedRefs {
+		// A manifest is only unreferenced if no tag pointing to it is kept
+		if !keptRefs[digestRef] {
+			unreferenced = append(unreferenced, digestRef)
+		}
+	}
+	for digestRef := range keptRefs {
+		kept = append(kept, digestRef)
+	}
+
+	sort.Strings(unreferenced)
+	sort.Strings(kept)
+
+	return unreferenced, kept
+}
+
+// cosignSubjectDigest returns the digest a cosign tag (i.e. sha256-<hex>.sig) is attached to or an empty string if it is not a cosign tag.
+func cosignSubjectDigest(tag string) string {
+	for _, suffix := range cosignTagSuffixes {
+		if !strings.HasSuffix(tag, suffix) {
+			continue
+		}
+		if algorithm, hex, ok := strings.Cut(strings.TrimSuffix(tag, suffix), "-"); ok {
+			return fmt.Sprintf("%s:%s", algorithm, hex)
+		}
+	}
+	return ""
+}
+
+// addManifestBlobs adds the config and layer blobs of a manifest (and of every manifest in an index) to blobs, keyed by digest.
+func addManifestBlobs(digestRef string, blobs map[string]int64, authOption crane.Option) error {
+	rawManifest, err := crane.Manifest(digestRef, authOption)
+	if err != nil {
+		return fmt.Errorf("unable to get the manifest of %s: %w", digestRef, err)
+	}
+
+	var mediaType struct {
+		MediaType ocitypes.MediaType `json:"mediaType"`
+	}
+	if err := json.Unmarshal(rawManifest, &mediaType); err != nil {
+		return fmt.Errorf("unable to read the manifest of %s: %w", digestRef, err)
+	}
+
+	if mediaType.MediaType.IsIndex() {
+		index, err := v1.ParseIndexManifest(bytes.NewReader(rawManifest))
+		if err != nil {
+			return fmt.Errorf("unable to read the index of %s: %w", digestRef, err)
+		}
+
+		name, _, _ := strings.Cut(digestRef, "@")
+		for _, descriptor := range index.Manifests {
+			if err := addManifestBlobs(fmt.Sprintf("%s@%s", name, descriptor.Digest), blobs, authOption); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	manifest, err := v1.ParseManifest(bytes.NewReader(rawManifest))
+	if err != nil {
+		return fmt.Errorf("unable to read the manifest of %s: %w", digestRef, err)
+	}
+
+	blobs[manifest.Config.Digest.String()] = manifest.Config.Size
+	for _, layer := range manifest.Layers {
+		blobs[layer.Digest.String()] = layer.Size
+	}
+
+	return nil
+}
+
+// reclaimableBytes returns the total size of the unreferenced blobs that no kept manifest shares.
+func reclaimableBytes(unreferencedBlobs, keptBlobs map[string]int64) (total int64) {
+	for digest, size := range unreferencedBlobs {
+		if _, ok := keptBlobs[digest]; !ok {
+			total += size
+		}
+	}
+	return total
+}
+
+// isNotFound returns whether an error from the registry API is a 404.
+func isNotFound(err error) bool {
+	var transportErr *transport.Error
+	return errors.As(err, &transportErr) && transportErr.StatusCode == http.StatusNotFound
+}
@@ -0,0 +1,146 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package cluster contains Zarf-specific cluster management functions.
+package cluster
+
+import (
+	"testing"
+
+	"github.com/defenseunicorns/zarf/src/types"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSplitUnreferencedManifests verifies that Zarf only collects the registry manifests nothing references.
+func TestSplitUnreferencedManifests(t *testing.T) {
+	t.Parallel()
+
+	const (
+		registry = "127.0.0.1:31999"
+		used     = "sha256:1111111111111111111111111111111111111111111111111111111111111111"
+		unused   = "sha256:2222222222222222222222222222222222222222222222222222222222222222"
+		sig      = "sha256:3333333333333333333333333333333333333333333333333333333333333333"
+		orphan   = "sha256:4444444444444444444444444444444444444444444444444444444444444444"
+	)
+
+	type testCase struct {
+		name                 string
+		tagDigests           map[string]string
+		referenced           map[string]bool
+		expectedUnreferenced []string
+		expectedKept         []string
+	}
+
+	testCases := []testCase{
+		{
+			name: "unreferenced digests are collected",
+			tagDigests: map[string]string{
+				registry + "/library/app:1.0.0": unused,
+				registry + "/library/app:2.0.0": used,
+			},
+			referenced:           map[string]bool{used: true},
+			expectedUnreferenced: []string{registry + "/library/app@" + unused},
+			expectedKept:         []string{registry + "/library/app@" + used},
+		},
+		{
+			name: "a digest is kept if any of its tags is referenced",
+			tagDigests: map[string]string{
+				registry + "/library/app:2.0.0":                 used,
+				registry + "/library/app:2.0.0-zarf-2823281104": used,
+			},
+			referenced:   map[string]bool{used: true},
+			expectedKept: []string{registry + "/library/app@" + used},
+		},
+		{
+			name: "cosign tags follow the digest they are attached to",
+			tagDigests: map[string]string{
+				registry + "/library/app:2.0.0": used,
+				registry + "/library/app:sha256-1111111111111111111111111111111111111111111111111111111111111111.sig": sig,
+				registry + "/library/app:sha256-2222222222222222222222222222222222222222222222222222222222222222.att": orphan,
+			},
+			referenced:           map[string]bool{used: true},
+			expectedUnreferenced: []string{registry + "/library/app@" + orphan},
+			expectedKept:         []string{registry + "/library/app@" + used, registry + "/library/app@" + sig},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			unreferenced, kept := splitUnreferencedManifests(testCase.tagDigests, testCase.referenced)
+			require.Equal(t, testCase.expectedUnreferenced, unreferenced)
+			require.Equal(t, testCase.expectedKept, kept)
+		})
+	}
+}
+
+// TestReclaimableBytes verifies that Zarf does not count blobs shared with kept manifests as reclaimable.
+func TestReclaimableBytes(t *testing.T) {
+	t.Parallel()
+
+	unreferencedBlobs := map[string]int64{"sha256:config": 10, "sha256:shared": 100, "sha256:layer": 1000}
+	keptBlobs := map[string]int64{"sha256:shared": 100, "sha256:other": 5}
+
+	require.Equal(t, int64(1010), reclaimableBytes(unreferencedBlobs, keptBlobs))
+	require.Equal(t, int64(0), reclaimableBytes(map[string]int64{}, keptBlobs))
+}
+
+// TestPackageSBOMReference verifies that each version of a package stores its SBOMs under a valid tag.
+func TestPackageSBOMReference(t *testing.T) {
+	t.Parallel()
+
+	pkg := types.ZarfPackage{Metadata: types.ZarfMetadata{Name: "podinfo", Version: "6.4.0+build.1"}}
+	require.Equal(t, "127.0.0.1:31999/zarf-sboms/podinfo:6.4.0_build.1", PackageSBOMReference("127.0.0.1:31999", pkg))
+
+	pkg.Metadata.Version = ""
+	require.Equal(t, "127.0.0.1:31999/zarf-sboms/podinfo:latest", PackageSBOMReference("127.0.0.1:31999", pkg))
+}
+
+// TestIsPrunableRepository verifies that Zarf only prunes the repositories that the images of deployed packages are pushed to.
+func TestIsPrunableRepository(t *testing.T) {
+	t.Parallel()
+
+	deployedPackages := []types.DeployedPackage{
+		{
+			Name: "podinfo",
+			Data: types.ZarfPackage{
+				Components: []types.ZarfComponent{
+					{Name: "podinfo", Images: []string{"ghcr.io/stefanprodan/podinfo:6.4.0"}},
+					{Name: "nginx", Images: []string{"nginx:1.25"}},
+				},
+			},
+			DeployedComponents: []types.DeployedComponent{{Name: "podinfo"}},
+		},
+	}
+	packageRepositories, err := getPackageRepositories(deployedPackages)
+	require.NoError(t, err)
+
+	type testCase struct {
+		name       string
+		repository string
+		expected   bool
+	}
+
+	testCases := []testCase{
+		{name: "images of deployed components are pruned", repository: "stefanprodan/podinfo", expected: true},
+		{name: "images of removed components are pruned", repository: "library/nginx", expected: true},
+		{name: "images no deployed package pushed are kept", repository: "library/busybox", expected: false},
+		{name: "package SBOMs are kept", repository: ZarfSBOMRepository + "/podinfo", expected: false},
+	}
+
+	// SBOMs are kept even if an image of a package is pushed to the same repository
+	require.False(t, isPrunableRepository(ZarfSBOMRepository+"/podinfo", map[string]bool{ZarfSBOMRepository + "/podinfo": true}))
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, testCase.expected, isPrunableRepository(testCase.repository, packageRepositories))
+		})
+	}
+}
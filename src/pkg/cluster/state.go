@@ -6,7 +6,9 @@ package cluster
 
 import (
 	"encoding/json"
+	"encoding/pem"
 	"fmt"
+	"os"
 	"time"
 
 	"slices"
@@ -149,6 +151,14 @@ func (c *Cluster) InitZarfState(initOptions types.ZarfInitOptions) error {
 		state.StorageClass = initOptions.StorageClass
 	}
 
+	// Keys provided on a re-init replace the ones the agent currently trusts
+	if len(initOptions.ImageVerifyKeys) > 0 {
+		spinner.Updatef("Loading the image signature public keys for the Zarf agent")
+		if state.ImageVerification, err = getImageVerificationInfo(initOptions.ImageVerifyKeys); err != nil {
+			return err
+		}
+	}
+
 	spinner.Success()
 
 	// Save the state back to K8s
@@ -319,6 +329,24 @@ func (c *Cluster) MergeZarfState(oldState *types.ZarfState, initOptions types.Za
 	return &newState, nil
 }
 
+// getImageVerificationInfo reads the cosign public keys the Zarf agent verifies image signatures with.
+func getImageVerificationInfo(keyPaths []string) (types.ImageVerificationInfo, error) {
+	info := types.ImageVerificationInfo{}
+
+	for _, keyPath := range keyPaths {
+		publicKey, err := os.ReadFile(keyPath)
+		if err != nil {
+			return info, fmt.Errorf("unable to read the public key %s: %w", keyPath, err)
+		}
+		if block, _ := pem.Decode(publicKey); block == nil {
+			return info, fmt.Errorf("the public key %s is not PEM encoded", keyPath)
+		}
+		info.PublicKeys = append(info.PublicKeys, string(publicKey))
+	}
+
+	return info, nil
+}
+
 func (c *Cluster) fillInEmptyContainerRegistryValues(containerRegistry types.RegistryInfo) (types.RegistryInfo, error) {
 	var err error
 	// Set default NodePort if none was provided
@@ -6,9 +6,11 @@ package interactive
 
 import (
 	"fmt"
+	"slices"
 
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/defenseunicorns/zarf/src/config"
+	"github.com/defenseunicorns/zarf/src/internal/packager/variables"
 	"github.com/defenseunicorns/zarf/src/pkg/message"
 	"github.com/defenseunicorns/zarf/src/types"
 )
@@ -39,12 +41,34 @@ func PromptVariable(variable types.ZarfPackageVariable) (value string, err error
 		message.Question(variable.Description)
 	}
 
+	// Enum variables select from their choices rather than taking free text
+	if variable.Type == types.EnumVariableType {
+		prompt := &survey.Select{
+			Message: fmt.Sprintf("Please select a value for \"%s\"", variable.Name),
+			Options: variable.Choices,
+		}
+		if slices.Contains(variable.Choices, variable.Default) {
+			prompt.Default = variable.Default
+		}
+
+		if err = survey.AskOne(prompt, &value); err != nil {
+			return "", err
+		}
+
+		return value, nil
+	}
+
 	prompt := &survey.Input{
 		Message: fmt.Sprintf("Please provide a value for \"%s\"", variable.Name),
 		Default: variable.Default,
 	}
 
-	if err = survey.AskOne(prompt, &value); err != nil {
+	// Ask again until the value is valid for the type of the variable
+	validator := func(ans interface{}) error {
+		return variables.Validate(variable, ans.(string))
+	}
+
+	if err = survey.AskOne(prompt, &value, survey.WithValidator(validator)); err != nil {
 		return "", err
 	}
 
@@ -7,7 +7,9 @@ package k8s
 import (
 	"context"
 
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/dynamic"
@@ -24,24 +26,94 @@ func (k *K8s) RemoveLabelsAndAnnotations(resourceNamespace string, resourceName
 	return k.updateLabelsAndAnnotations(resourceNamespace, resourceName, groupKind, labels, annotations, true)
 }
 
-// updateLabelsAndAnnotations updates the provided labels and annotations to the specified K8s resource
-func (k *K8s) updateLabelsAndAnnotations(resourceNamespace string, resourceName string, groupKind schema.GroupKind, labels map[string]string, annotations map[string]string, isRemove bool) error {
+// GetResource returns the specified K8s resource as it currently exists in the cluster
+func (k *K8s) GetResource(resourceNamespace string, resourceName string, groupKind schema.GroupKind) (*unstructured.Unstructured, error) {
+	resourceClient, err := k.getDynamicResourceClient(resourceNamespace, groupKind)
+	if err != nil {
+		return nil, err
+	}
+
+	return resourceClient.Get(context.TODO(), resourceName, metav1.GetOptions{})
+}
+
+// ResourceGetter gets K8s resources of any kind from the cluster with a single discovery of its API resources
+type ResourceGetter struct {
+	dynamicClient dynamic.Interface
+	mapper        meta.RESTMapper
+}
+
+// NewResourceGetter discovers the API resources of the cluster once to get any number of resources with
+func (k *K8s) NewResourceGetter() (*ResourceGetter, error) {
+	dynamicClient, err := dynamic.NewForConfig(k.RestConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	mapper, err := k.newRESTMapper()
+	if err != nil {
+		return nil, err
+	}
+
+	return &ResourceGetter{dynamicClient: dynamicClient, mapper: mapper}, nil
+}
+
+// Get returns the specified K8s resource as it currently exists in the cluster
+func (g *ResourceGetter) Get(resourceNamespace string, resourceName string, groupKind schema.GroupKind) (*unstructured.Unstructured, error) {
+	resourceClient, err := newResourceClient(g.dynamicClient, g.mapper, resourceNamespace, groupKind)
+	if err != nil {
+		return nil, err
+	}
+
+	return resourceClient.Get(context.TODO(), resourceName, metav1.GetOptions{})
+}
+
+// getDynamicResourceClient returns a dynamic client for the specified kind of K8s resource
+func (k *K8s) getDynamicResourceClient(resourceNamespace string, groupKind schema.GroupKind) (dynamic.ResourceInterface, error) {
 	dynamicClient := dynamic.NewForConfigOrDie(k.RestConfig)
 
+	mapper, err := k.newRESTMapper()
+	if err != nil {
+		return nil, err
+	}
+
+	return newResourceClient(dynamicClient, mapper, resourceNamespace, groupKind)
+}
+
+// newRESTMapper returns a RESTMapper of the API resources the cluster currently serves
+func (k *K8s) newRESTMapper() (meta.RESTMapper, error) {
 	discoveryClient := discovery.NewDiscoveryClientForConfigOrDie(k.RestConfig)
 
 	groupResources, err := restmapper.GetAPIGroupResources(discoveryClient)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	mapper := restmapper.NewDiscoveryRESTMapper(groupResources)
 
+	return restmapper.NewDiscoveryRESTMapper(groupResources), nil
+}
+
+// newResourceClient returns a dynamic client for the specified kind of K8s resource using the given mapper
+func newResourceClient(dynamicClient dynamic.Interface, mapper meta.RESTMapper, resourceNamespace string, groupKind schema.GroupKind) (dynamic.ResourceInterface, error) {
 	mapping, err := mapper.RESTMapping(groupKind)
+	if err != nil {
+		return nil, err
+	}
+
+	// Cluster-scoped resources ignore the namespace
+	if mapping.Scope.Name() == meta.RESTScopeNameRoot {
+		return dynamicClient.Resource(mapping.Resource), nil
+	}
+
+	return dynamicClient.Resource(mapping.Resource).Namespace(resourceNamespace), nil
+}
+
+// updateLabelsAndAnnotations updates the provided labels and annotations to the specified K8s resource
+func (k *K8s) updateLabelsAndAnnotations(resourceNamespace string, resourceName string, groupKind schema.GroupKind, labels map[string]string, annotations map[string]string, isRemove bool) error {
+	resourceClient, err := k.getDynamicResourceClient(resourceNamespace, groupKind)
 	if err != nil {
 		return err
 	}
 
-	deployedResource, err := dynamicClient.Resource(mapping.Resource).Namespace(resourceNamespace).Get(context.TODO(), resourceName, metav1.GetOptions{})
+	deployedResource, err := resourceClient.Get(context.TODO(), resourceName, metav1.GetOptions{})
 	if err != nil {
 		return err
 	}
@@ -78,6 +150,6 @@ func (k *K8s) updateLabelsAndAnnotations(resourceNamespace string, resourceName
 
 	deployedResource.SetAnnotations(deployedAnnotations)
 
-	_, err = dynamicClient.Resource(mapping.Resource).Namespace(resourceNamespace).Update(context.TODO(), deployedResource, metav1.UpdateOptions{})
+	_, err = resourceClient.Update(context.TODO(), deployedResource, metav1.UpdateOptions{})
 	return err
 }
@@ -57,3 +57,30 @@ func (i *Images) AddV1Image(img v1.Image) error {
 
 	return nil
 }
+
+// AddV1ImageIndex adds a multi-platform v1.ImageIndex and each of its images to the Images struct.
+func (i *Images) AddV1ImageIndex(idx v1.ImageIndex) error {
+	idxManifest, err := idx.IndexManifest()
+	if err != nil {
+		return err
+	}
+	for _, manifest := range idxManifest.Manifests {
+		if !manifest.MediaType.IsImage() {
+			continue
+		}
+		img, err := idx.Image(manifest.Digest)
+		if err != nil {
+			return err
+		}
+		if err := i.AddV1Image(img); err != nil {
+			return err
+		}
+	}
+	idxSha, err := idx.Digest()
+	if err != nil {
+		return err
+	}
+	i.AddBlob(idxSha.Hex)
+
+	return nil
+}
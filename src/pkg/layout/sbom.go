@@ -10,6 +10,7 @@ import (
 	"path/filepath"
 
 	"github.com/defenseunicorns/zarf/src/pkg/utils"
+	"github.com/defenseunicorns/zarf/src/types"
 	"github.com/mholt/archiver/v3"
 )
 
@@ -17,6 +18,14 @@ import (
 type ComponentSBOM struct {
 	Files     []string
 	Component *ComponentPaths
+	Charts    []types.ZarfChart
+	Manifests []types.ZarfManifest
+	Repos     []string
+}
+
+// IsEmpty returns true if the component has nothing to catalog in an SBOM.
+func (c *ComponentSBOM) IsEmpty() bool {
+	return len(c.Files) == 0 && len(c.Charts) == 0 && len(c.Manifests) == 0 && len(c.Repos) == 0
 }
 
 // SBOMs contains paths for SBOMs.
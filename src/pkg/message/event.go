@@ -0,0 +1,164 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package message provides a rich set of functions for displaying messages to the user.
+package message
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+)
+
+// EventType is the type of a structured event.
+type EventType string
+
+const (
+	// EventOperationStarted is emitted when a packager operation (create, deploy, remove, mirror, publish, pull) starts.
+	EventOperationStarted EventType = "operation.started"
+	// EventOperationFinished is emitted when a packager operation finishes, successfully or not.
+	EventOperationFinished EventType = "operation.finished"
+	// EventComponentStarted is emitted when an operation starts working on a component.
+	EventComponentStarted EventType = "component.started"
+	// EventComponentFinished is emitted when an operation finishes working on a component, successfully or not.
+	EventComponentFinished EventType = "component.finished"
+	// EventImagePushed is emitted after an image is pushed to a registry.
+	EventImagePushed EventType = "image.pushed"
+	// EventRepoPushed is emitted after a git repository is pushed to a git server.
+	EventRepoPushed EventType = "repo.pushed"
+	// EventChartInstalled is emitted after a Helm chart or manifest is installed or upgraded.
+	EventChartInstalled EventType = "chart.installed"
+	// EventActionRun is emitted after a component action runs.
+	EventActionRun EventType = "action.run"
+)
+
+// Event is a structured, machine-readable record of something a packager operation did.
+type Event struct {
+	Time       time.Time `json:"time"`
+	Type       EventType `json:"type"`
+	Operation  string    `json:"operation,omitempty"`
+	Package    string    `json:"package,omitempty"`
+	Source     string    `json:"source,omitempty"`
+	Component  string    `json:"component,omitempty"`
+	Image      string    `json:"image,omitempty"`
+	Repo       string    `json:"repo,omitempty"`
+	Chart      string    `json:"chart,omitempty"`
+	Namespace  string    `json:"namespace,omitempty"`
+	Action     string    `json:"action,omitempty"`
+	DurationMs int64     `json:"durationMs,omitempty"`
+	Error      string    `json:"error,omitempty"`
+}
+
+var (
+	// eventWriter is the stream to write events to, events are only written when it is set
+	eventWriter io.Writer
+	// eventMutex keeps events written from parallel component deployments from interleaving
+	eventMutex sync.Mutex
+	// eventOperation is the packager operation that is currently running
+	eventOperation string
+	// eventSource is the package source the current operation was started with
+	eventSource string
+	// eventPackage is the name of the package the current operation is working on
+	eventPackage string
+)
+
+// EnableEvents writes structured events as JSON lines to the given writer.
+func EnableEvents(w io.Writer) {
+	eventMutex.Lock()
+	defer eventMutex.Unlock()
+
+	eventWriter = w
+}
+
+// EventsEnabled returns whether structured events are being written.
+func EventsEnabled() bool {
+	eventMutex.Lock()
+	defer eventMutex.Unlock()
+
+	return eventWriter != nil
+}
+
+// SetEventPackage sets the name of the package that the events of the current operation are about.
+func SetEventPackage(name string) {
+	eventMutex.Lock()
+	defer eventMutex.Unlock()
+
+	eventPackage = name
+}
+
+// StartOperation emits an operation started event for a package source and returns a function that emits its finished event.
+func StartOperation(operation string, source string) func(err error) {
+	eventMutex.Lock()
+	eventOperation = operation
+	eventSource = source
+	eventPackage = ""
+	eventMutex.Unlock()
+
+	start := time.Now()
+	EmitEvent(Event{Type: EventOperationStarted})
+
+	return func(err error) {
+		EmitEvent(finishedEvent(Event{Type: EventOperationFinished}, start, err))
+	}
+}
+
+// StartComponent emits a component started event and returns a function that emits its finished event.
+func StartComponent(component string) func(err error) {
+	start := time.Now()
+	EmitEvent(Event{Type: EventComponentStarted, Component: component})
+
+	return func(err error) {
+		EmitEvent(finishedEvent(Event{Type: EventComponentFinished, Component: component}, start, err))
+	}
+}
+
+// TimeEvent starts timing an event and returns a function that emits it with its duration and error.
+func TimeEvent(event Event) func(err error) {
+	start := time.Now()
+
+	return func(err error) {
+		EmitEvent(finishedEvent(event, start, err))
+	}
+}
+
+// EmitEvent writes an event as a JSON line if structured events are enabled.
+func EmitEvent(event Event) {
+	eventMutex.Lock()
+	defer eventMutex.Unlock()
+
+	if eventWriter == nil {
+		return
+	}
+
+	if event.Time.IsZero() {
+		event.Time = time.Now()
+	}
+	if event.Operation == "" {
+		event.Operation = eventOperation
+	}
+	if event.Source == "" {
+		event.Source = eventSource
+	}
+	if event.Package == "" {
+		event.Package = eventPackage
+	}
+
+	line, err := json.Marshal(event)
+	if err != nil {
+		debugPrinter(2, "Unable to marshal event:", err.Error())
+		return
+	}
+
+	if _, err := eventWriter.Write(append(line, '\n')); err != nil {
+		debugPrinter(2, "Unable to write event:", err.Error())
+	}
+}
+
+func finishedEvent(event Event, start time.Time, err error) Event {
+	event.DurationMs = time.Since(start).Milliseconds()
+	if err != nil {
+		event.Error = err.Error()
+	}
+	return event
+}
@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package message provides a rich set of functions for displaying messages to the user.
+package message
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestEventSchema verifies the JSON lines written for events, since other tools parse them.
+func TestEventSchema(t *testing.T) {
+	// Not parallel since events are written to a global writer
+	var buf bytes.Buffer
+	EnableEvents(&buf)
+	t.Cleanup(func() { EnableEvents(nil) })
+	require.True(t, EventsEnabled())
+
+	source := "oci://ghcr.io/defenseunicorns/packages/dos-games:1.0.0"
+	finishOperation := StartOperation("deploy", source)
+	SetEventPackage("dos-games")
+	finishComponent := StartComponent("baseline")
+	TimeEvent(Event{Type: EventImagePushed, Component: "baseline", Image: "defenseunicorns/zarf-game:multi-tile-dark"})(nil)
+	finishComponent(errors.New("component failed"))
+	finishOperation(nil)
+
+	events := []map[string]any{}
+	scanner := bufio.NewScanner(&buf)
+	for scanner.Scan() {
+		event := map[string]any{}
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &event))
+		events = append(events, event)
+	}
+	require.NoError(t, scanner.Err())
+	require.Len(t, events, 5)
+
+	expected := []map[string]any{
+		{"type": "operation.started", "operation": "deploy", "source": source},
+		{"type": "component.started", "operation": "deploy", "source": source, "package": "dos-games", "component": "baseline"},
+		{"type": "image.pushed", "operation": "deploy", "source": source, "package": "dos-games", "component": "baseline", "image": "defenseunicorns/zarf-game:multi-tile-dark"},
+		{"type": "component.finished", "operation": "deploy", "source": source, "package": "dos-games", "component": "baseline", "error": "component failed"},
+		{"type": "operation.finished", "operation": "deploy", "source": source, "package": "dos-games"},
+	}
+	for i, event := range events {
+		eventTime, ok := event["time"].(string)
+		require.True(t, ok)
+		_, err := time.Parse(time.RFC3339Nano, eventTime)
+		require.NoError(t, err)
+		delete(event, "time")
+		// The duration is left out when it rounds down to zero milliseconds
+		delete(event, "durationMs")
+		require.Equal(t, expected[i], event)
+	}
+
+	// Nothing is written once events are disabled
+	EnableEvents(nil)
+	require.False(t, EventsEnabled())
+	EmitEvent(Event{Type: EventActionRun})
+	require.Empty(t, buf.String())
+}
@@ -5,6 +5,8 @@
 package message
 
 import (
+	"sync"
+
 	"github.com/pterm/pterm"
 )
 
@@ -14,6 +16,8 @@ const padding = "    "
 type ProgressBar struct {
 	progress  *pterm.ProgressbarPrinter
 	startText string
+	// mu keeps concurrent uploads from racing on the progress and title
+	mu sync.Mutex
 }
 
 // NewProgressBar creates a new ProgressBar instance from a total value and a format.
@@ -43,9 +47,12 @@ func (p *ProgressBar) Update(complete int64, text string) {
 		debugPrinter(2, text)
 		return
 	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
 	p.progress.UpdateTitle(padding + text)
 	chunk := int(complete) - p.progress.Current
-	p.Add(chunk)
+	p.add(chunk)
 }
 
 // UpdateTitle updates the ProgressBar with new text.
@@ -54,11 +61,22 @@ func (p *ProgressBar) UpdateTitle(text string) {
 		debugPrinter(2, text)
 		return
 	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
 	p.progress.UpdateTitle(padding + text)
 }
 
 // Add updates the ProgressBar with completed progress.
 func (p *ProgressBar) Add(n int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.add(n)
+}
+
+// add updates the progress, the caller must hold the lock.
+func (p *ProgressBar) add(n int) {
 	if p.progress != nil {
 		if p.progress.Current+n >= p.progress.Total {
 			// @RAZZLE TODO: This is a hack to prevent the progress bar from going over 100% and causing TUI ugliness.
@@ -72,9 +90,7 @@ func (p *ProgressBar) Add(n int) {
 // Write updates the ProgressBar with the number of bytes in a buffer as the completed progress.
 func (p *ProgressBar) Write(data []byte) (int, error) {
 	n := len(data)
-	if p.progress != nil {
-		p.Add(n)
-	}
+	p.Add(n)
 	return n, nil
 }
 
@@ -86,6 +102,9 @@ func (p *ProgressBar) Successf(format string, a ...any) {
 
 // Stop stops the ProgressBar from continuing.
 func (p *ProgressBar) Stop() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
 	if p.progress != nil {
 		_, _ = p.progress.Stop()
 	}
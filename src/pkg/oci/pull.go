@@ -6,6 +6,7 @@ package oci
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -137,21 +138,43 @@ func (o *OrasRemote) LayersFromRequestedComponents(requestedComponents []string)
 					(layer.Annotations[ocispec.AnnotationBaseImageName] == refInfo.Path+refInfo.TagOrDigest && refInfo.Host == "docker.io")
 			})
 
-			// even though these are technically image manifests, we store them as Zarf blobs
-			manifestDescriptor.MediaType = ZarfLayerMediaTypeBlob
+			manifestDescriptors := []ocispec.Descriptor{manifestDescriptor}
 
-			manifest, err := o.FetchManifest(manifestDescriptor)
-			if err != nil {
-				return nil, err
+			// Images kept with several platforms are stored as an image index, so add it and every manifest in it
+			if isImageIndex(manifestDescriptor) {
+				manifestDescriptor.MediaType = ZarfLayerMediaTypeBlob
+
+				imageIndex, err := FetchUnmarshal[*ocispec.Index](o.FetchLayer, json.Unmarshal, manifestDescriptor)
+				if err != nil {
+					return nil, err
+				}
+				layers = append(layers, root.Locate(filepath.Join(ZarfPackageImagesBlobsDir, manifestDescriptor.Digest.Encoded())))
+
+				manifestDescriptors = imageIndex.Manifests
 			}
-			// Add the manifest and the manifest config layers
-			layers = append(layers, root.Locate(filepath.Join(ZarfPackageImagesBlobsDir, manifestDescriptor.Digest.Encoded())))
-			layers = append(layers, root.Locate(filepath.Join(ZarfPackageImagesBlobsDir, manifest.Config.Digest.Encoded())))
-
-			// Add all the layers from the manifest
-			for _, layer := range manifest.Layers {
-				layerPath := filepath.Join(ZarfPackageImagesBlobsDir, layer.Digest.Encoded())
-				layers = append(layers, root.Locate(layerPath))
+
+			// Add the signatures, attestations and referrers that were packaged for the image
+			manifestDescriptors = append(manifestDescriptors, helpers.Filter(index.Manifests, func(layer ocispec.Descriptor) bool {
+				return layer.Annotations[utils.ImageSubjectAnnotation] == refInfo.Reference
+			})...)
+
+			for _, manifestDescriptor := range manifestDescriptors {
+				// even though these are technically image manifests, we store them as Zarf blobs
+				manifestDescriptor.MediaType = ZarfLayerMediaTypeBlob
+
+				manifest, err := o.FetchManifest(manifestDescriptor)
+				if err != nil {
+					return nil, err
+				}
+				// Add the manifest and the manifest config layers
+				layers = append(layers, root.Locate(filepath.Join(ZarfPackageImagesBlobsDir, manifestDescriptor.Digest.Encoded())))
+				layers = append(layers, root.Locate(filepath.Join(ZarfPackageImagesBlobsDir, manifest.Config.Digest.Encoded())))
+
+				// Add all the layers from the manifest
+				for _, layer := range manifest.Layers {
+					layerPath := filepath.Join(ZarfPackageImagesBlobsDir, layer.Digest.Encoded())
+					layers = append(layers, root.Locate(layerPath))
+				}
 			}
 		}
 	}
@@ -309,3 +332,8 @@ func (o *OrasRemote) PullPackageMetadata(destinationDir string) ([]ocispec.Descr
 func (o *OrasRemote) PullPackageSBOM(destinationDir string) ([]ocispec.Descriptor, error) {
 	return o.PullPackagePaths([]string{layout.SBOMTar}, destinationDir)
 }
+
+// isImageIndex returns whether a descriptor in the images index.json is a multi-platform image index rather than an image manifest.
+func isImageIndex(desc ocispec.Descriptor) bool {
+	return desc.MediaType == ocispec.MediaTypeImageIndex || desc.MediaType == "application/vnd.docker.distribution.manifest.list.v2+json"
+}
@@ -22,7 +22,18 @@ import (
 
 func (p *Packager) runActions(defaultCfg types.ZarfComponentActionDefaults, actions []types.ZarfComponentAction, valueTemplate *template.Values) error {
 	for _, a := range actions {
-		if err := p.runAction(defaultCfg, a, valueTemplate); err != nil {
+		name := a.Description
+		if name == "" {
+			name = a.Cmd
+		}
+		if name == "" && a.Wait != nil {
+			name = "wait"
+		}
+
+		finishEvent := message.TimeEvent(message.Event{Type: message.EventActionRun, Action: name})
+		err := p.runAction(defaultCfg, a, valueTemplate)
+		finishEvent(err)
+		if err != nil {
 			return err
 		}
 	}
@@ -12,6 +12,7 @@ import (
 	"path/filepath"
 	"regexp"
 	"strings"
+	"sync"
 	"time"
 
 	"slices"
@@ -46,6 +47,17 @@ type Packager struct {
 	sbomViewFiles  []string
 	source         sources.PackageSource
 	generation     int
+	rollback       *deployRollback
+	// previousVariables are the variable values the previous deployment of the package was recorded with
+	previousVariables map[string]string
+	// deployedVariables are the variables to record with this deployment of the package
+	deployedVariables map[string]types.DeployedVariable
+	// orphanedComponents are the deployed components an upgrade will remove
+	orphanedComponents []orphanedComponent
+	// confirmed is whether the package was already confirmed (i.e. with the rest of a bundle)
+	confirmed bool
+	// deployMutex guards state shared by components deploying in parallel
+	deployMutex sync.Mutex
 }
 
 // Zarf Packager Variables.
@@ -83,6 +95,13 @@ func WithTemp(base string) Modifier {
 	}
 }
 
+// WithConfirmed skips the confirmation of the package as it was already confirmed, leaving any other prompts alone.
+func WithConfirmed() Modifier {
+	return func(p *Packager) {
+		p.confirmed = true
+	}
+}
+
 /*
 New creates a new package instance with the provided config.
 
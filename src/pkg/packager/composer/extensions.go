@@ -5,10 +5,10 @@
 package composer
 
 import (
-	"github.com/defenseunicorns/zarf/src/extensions/bigbang"
+	"github.com/defenseunicorns/zarf/src/extensions"
 	"github.com/defenseunicorns/zarf/src/types"
 )
 
 func composeExtensions(c *types.ZarfComponent, override types.ZarfComponent, relativeTo string) {
-	bigbang.Compose(c, override, relativeTo)
+	extensions.Compose(c, override, relativeTo)
 }
@@ -5,21 +5,33 @@
 package packager
 
 import (
+	"errors"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/defenseunicorns/zarf/src/config"
+	"github.com/defenseunicorns/zarf/src/config/lang"
+	"github.com/defenseunicorns/zarf/src/internal/packager/sbom"
 	"github.com/defenseunicorns/zarf/src/internal/packager/validate"
+	"github.com/defenseunicorns/zarf/src/pkg/message"
+	"github.com/defenseunicorns/zarf/src/types"
 )
 
 // Create generates a Zarf package tarball for a given PackageConfig and optional base directory.
 func (p *Packager) Create() (err error) {
+	finishEvent := message.StartOperation("create", p.cfg.CreateOpts.BaseDir)
+	defer func() { finishEvent(err) }()
 
 	cwd, err := os.Getwd()
 	if err != nil {
 		return err
 	}
 
+	if err := validateScanOptions(p.cfg.CreateOpts); err != nil {
+		return err
+	}
+
 	if err := p.cdToBaseDir(p.cfg.CreateOpts.BaseDir, cwd); err != nil {
 		return err
 	}
@@ -48,3 +60,22 @@ func (p *Packager) Create() (err error) {
 
 	return p.output()
 }
+
+// validateScanOptions checks that the vulnerability scan flags can be used together.
+func validateScanOptions(createOpts types.ZarfCreateOptions) error {
+	if createOpts.VulnDBPath != "" && createOpts.SkipSBOM {
+		return errors.New(lang.PkgCreateErrVulnDBSkipSBOM)
+	}
+
+	if createOpts.FailOnSeverity == "" {
+		return nil
+	}
+	if createOpts.VulnDBPath == "" {
+		return errors.New(lang.PkgCreateErrFailOnWithoutVulnDB)
+	}
+	if !sbom.IsValidSeverity(createOpts.FailOnSeverity) {
+		return fmt.Errorf(lang.PkgCreateErrFailOnSeverity, createOpts.FailOnSeverity, strings.Join(sbom.Severities, ", "))
+	}
+
+	return nil
+}
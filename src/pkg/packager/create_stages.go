@@ -42,6 +42,10 @@ func (p *Packager) cdToBaseDir(base string, cwd string) error {
 	if p.cfg.CreateOpts.DifferentialData.DifferentialPackagePath != "" {
 		p.cfg.CreateOpts.DifferentialData.DifferentialPackagePath = filepath.Join(cwd, p.cfg.CreateOpts.DifferentialData.DifferentialPackagePath)
 	}
+	// vulnerability databases are relative to the current working directory
+	if p.cfg.CreateOpts.VulnDBPath != "" && !filepath.IsAbs(p.cfg.CreateOpts.VulnDBPath) {
+		p.cfg.CreateOpts.VulnDBPath = filepath.Join(cwd, p.cfg.CreateOpts.VulnDBPath)
+	}
 	return nil
 }
 
@@ -121,7 +125,7 @@ func (p *Packager) assemble() error {
 			if err != nil {
 				return fmt.Errorf("unable to create component SBOM: %w", err)
 			}
-			if componentSBOM != nil && len(componentSBOM.Files) > 0 {
+			if componentSBOM != nil && !componentSBOM.IsEmpty() {
 				componentSBOMs[component.Name] = componentSBOM
 			}
 		}
@@ -148,15 +152,16 @@ func (p *Packager) assemble() error {
 		var pulled []images.ImgInfo
 		var err error
 
-		doPull := func() error {
-			imgConfig := images.ImageConfig{
-				ImagesPath:        p.layout.Images.Base,
-				ImageList:         imageList,
-				Insecure:          config.CommonOptions.Insecure,
-				Architectures:     []string{p.cfg.Pkg.Metadata.Architecture, p.cfg.Pkg.Build.Architecture},
-				RegistryOverrides: p.cfg.CreateOpts.RegistryOverrides,
-			}
+		imgConfig := images.ImageConfig{
+			ImagesPath:        p.layout.Images.Base,
+			ImageList:         imageList,
+			Insecure:          config.CommonOptions.Insecure,
+			Architectures:     []string{p.cfg.Pkg.Metadata.Architecture, p.cfg.Pkg.Build.Architecture},
+			RegistryOverrides: p.cfg.CreateOpts.RegistryOverrides,
+			Platforms:         p.cfg.CreateOpts.ImagePlatforms,
+		}
 
+		doPull := func() error {
 			pulled, err = imgConfig.PullAll()
 			return err
 		}
@@ -166,13 +171,28 @@ func (p *Packager) assemble() error {
 		}
 
 		for _, imgInfo := range pulled {
-			if err := p.layout.Images.AddV1Image(imgInfo.Img); err != nil {
+			if imgInfo.Index != nil {
+				if err := p.layout.Images.AddV1ImageIndex(imgInfo.Index); err != nil {
+					return err
+				}
+			} else if err := p.layout.Images.AddV1Image(imgInfo.Img); err != nil {
 				return err
 			}
 			if imgInfo.HasImageLayers {
 				sbomImageList = append(sbomImageList, imgInfo.RefInfo)
 			}
 		}
+
+		// Keep the signatures, attestations and referrers of the images so they can be verified in the airgap
+		artifacts, err := imgConfig.PullArtifacts(pulled)
+		if err != nil {
+			return fmt.Errorf("unable to pull the signatures and attestations of the images: %w", err)
+		}
+		for _, artifact := range artifacts {
+			if err := p.layout.Images.AddV1Image(artifact); err != nil {
+				return err
+			}
+		}
 	}
 
 	// Ignore SBOM creation if the flag is set.
@@ -180,9 +200,44 @@ func (p *Packager) assemble() error {
 		message.Debug("Skipping image SBOM processing per --skip-sbom flag")
 	} else {
 		p.layout = p.layout.AddSBOMs()
-		if err := sbom.Catalog(componentSBOMs, sbomImageList, p.layout); err != nil {
+		if err := sbom.Catalog(componentSBOMs, sbomImageList, p.layout, p.cfg.Pkg); err != nil {
 			return fmt.Errorf("unable to create an SBOM catalog for the package: %w", err)
 		}
+
+		if p.cfg.CreateOpts.VulnDBPath != "" {
+			if err := p.scanSBOMs(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// scanSBOMs scans the package SBOMs for vulnerabilities and stores the findings next to them, failing on the configured severity.
+func (p *Packager) scanSBOMs() error {
+	message.HeaderInfof("🔍 VULNERABILITY SCAN")
+
+	if err := p.layout.SBOMs.Unarchive(); err != nil {
+		return fmt.Errorf("unable to unarchive SBOMs: %w", err)
+	}
+
+	reports, err := sbom.Scan(p.layout.SBOMs.Path, p.cfg.CreateOpts.VulnDBPath)
+	if err != nil {
+		return fmt.Errorf("unable to scan the package for vulnerabilities: %w", err)
+	}
+
+	if err := p.layout.SBOMs.Archive(); err != nil {
+		return fmt.Errorf("unable to archive SBOMs: %w", err)
+	}
+
+	failOn := p.cfg.CreateOpts.FailOnSeverity
+	if failOn == "" {
+		return nil
+	}
+	if count := sbom.CountFindings(reports, failOn); count > 0 {
+		sbom.PrintScanReports(reports, failOn)
+		return fmt.Errorf(lang.PkgCreateErrVulnerabilities, count, failOn)
 	}
 
 	return nil
@@ -314,6 +369,9 @@ func (p *Packager) getFilesToSBOM(component types.ZarfComponent) (*layout.Compon
 	componentSBOM := &layout.ComponentSBOM{
 		Files:     []string{},
 		Component: componentPaths,
+		Charts:    component.Charts,
+		Manifests: component.Manifests,
+		Repos:     component.Repos,
 	}
 
 	appendSBOMFiles := func(path string) {
@@ -339,7 +397,10 @@ func (p *Packager) getFilesToSBOM(component types.ZarfComponent) (*layout.Compon
 	return componentSBOM, nil
 }
 
-func (p *Packager) addComponent(index int, component types.ZarfComponent) error {
+func (p *Packager) addComponent(index int, component types.ZarfComponent) (err error) {
+	finishEvent := message.StartComponent(component.Name)
+	defer func() { finishEvent(err) }()
+
 	message.HeaderInfof("📦 %s COMPONENT", strings.ToUpper(component.Name))
 
 	isSkeleton := p.cfg.CreateOpts.IsSkeleton
@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package packager contains functions for interacting with, managing and deploying Zarf packages.
+package packager
+
+import (
+	"testing"
+
+	"github.com/defenseunicorns/zarf/src/types"
+	"github.com/stretchr/testify/require"
+)
+
+// TestValidateScanOptions verifies that Zarf rejects vulnerability scan flags that cannot be used together.
+func TestValidateScanOptions(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name       string
+		createOpts types.ZarfCreateOptions
+		wantErr    bool
+	}
+
+	testCases := []testCase{
+		{
+			name:       "no scan",
+			createOpts: types.ZarfCreateOptions{},
+		},
+		{
+			name:       "scan without a threshold",
+			createOpts: types.ZarfCreateOptions{VulnDBPath: "db.tar.gz"},
+		},
+		{
+			name:       "scan with a threshold in any case",
+			createOpts: types.ZarfCreateOptions{VulnDBPath: "db.tar.gz", FailOnSeverity: "Critical"},
+		},
+		{
+			name:       "scan without SBOMs",
+			createOpts: types.ZarfCreateOptions{VulnDBPath: "db.tar.gz", SkipSBOM: true},
+			wantErr:    true,
+		},
+		{
+			name:       "threshold without a scan",
+			createOpts: types.ZarfCreateOptions{FailOnSeverity: "high"},
+			wantErr:    true,
+		},
+		{
+			name:       "unknown threshold",
+			createOpts: types.ZarfCreateOptions{VulnDBPath: "db.tar.gz", FailOnSeverity: "severe"},
+			wantErr:    true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateScanOptions(tc.createOpts)
+			if tc.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
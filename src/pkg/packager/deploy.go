@@ -5,6 +5,7 @@
 package packager
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -18,6 +19,7 @@ import (
 	"github.com/defenseunicorns/zarf/src/internal/packager/git"
 	"github.com/defenseunicorns/zarf/src/internal/packager/helm"
 	"github.com/defenseunicorns/zarf/src/internal/packager/images"
+	"github.com/defenseunicorns/zarf/src/internal/packager/sbom"
 	"github.com/defenseunicorns/zarf/src/internal/packager/template"
 	"github.com/defenseunicorns/zarf/src/pkg/cluster"
 	"github.com/defenseunicorns/zarf/src/pkg/k8s"
@@ -40,16 +42,17 @@ func (p *Packager) resetRegistryHPA() {
 
 // Deploy attempts to deploy the given PackageConfig.
 func (p *Packager) Deploy() (err error) {
-	if err = p.source.LoadPackage(p.layout, true); err != nil {
-		return fmt.Errorf("unable to load the package: %w", err)
-	}
+	finishEvent := message.StartOperation("deploy", p.cfg.PkgOpts.PackageSource)
+	defer func() { finishEvent(err) }()
 
-	if err = p.readZarfYAML(p.layout.ZarfYAML); err != nil {
+	if err = p.loadPackageForDeploy(); err != nil {
 		return err
 	}
 
-	if err := p.validateLastNonBreakingVersion(); err != nil {
-		return err
+	// Plans only report what would change, so skip the SBOM review and confirmation
+	if p.cfg.DeployOpts.Plan {
+		p.filterComponents()
+		return p.plan()
 	}
 
 	if err := p.stageSBOMViewFiles(); err != nil {
@@ -61,13 +64,45 @@ func (p *Packager) Deploy() (err error) {
 		return fmt.Errorf("deployment cancelled")
 	}
 
+	return p.deployPackage()
+}
+
+// loadPackageForDeploy loads the package being deployed and checks that this CLI can deploy it.
+func (p *Packager) loadPackageForDeploy() error {
+	if err := p.source.LoadPackage(p.layout, true); err != nil {
+		return fmt.Errorf("unable to load the package: %w", err)
+	}
+
+	if err := p.readZarfYAML(p.layout.ZarfYAML); err != nil {
+		return err
+	}
+
+	return p.validateLastNonBreakingVersion()
+}
+
+// deployPackage sets the active variables and deploys the selected components of a loaded and confirmed package.
+func (p *Packager) deployPackage() error {
+	// Default the variables to the values of the previous deployment of the package
+	if err := p.loadPreviousVariables(); err != nil {
+		return err
+	}
+
 	// Set variables and prompt if --confirm is not set
 	if err := p.setVariableMapInConfig(); err != nil {
 		return fmt.Errorf("unable to set the active variables: %w", err)
 	}
 
+	// Capture the variables before any actions set more of them
+	deployedVariables, err := p.getDeployedVariables()
+	if err != nil {
+		return err
+	}
+	p.deployedVariables = deployedVariables
+	p.printVariableChanges()
+
 	p.hpaModified = false
 	p.connectStrings = make(types.ConnectStrings)
+	p.rollback = &deployRollback{}
 	// Reset registry HPA scale down whether an error occurs or not
 	defer p.resetRegistryHPA()
 
@@ -77,12 +112,20 @@ func (p *Packager) Deploy() (err error) {
 	// Get a list of all the components we are deploying and actually deploy them
 	deployedComponents, err := p.deployComponents()
 	if err != nil {
+		if p.cfg.DeployOpts.Atomic {
+			if rollbackErr := p.rollbackDeployment(); rollbackErr != nil {
+				return errors.Join(err, fmt.Errorf("rollback failed: %w", rollbackErr))
+			}
+		}
 		return err
 	}
 	if len(deployedComponents) == 0 {
 		message.Warn("No components were selected for deployment.  Inspect the package to view the available components and select components interactively or by name with \"--components\"")
 	}
 
+	// Keep the SBOMs in the cluster so that the deployed package can be re-scanned for new vulnerabilities
+	p.storeSBOMs()
+
 	// Notify all the things about the successful deployment
 	message.Successf("Zarf deployment complete")
 
@@ -91,7 +134,22 @@ func (p *Packager) Deploy() (err error) {
 	return nil
 }
 
-// deployComponents loops through a list of ZarfComponents and deploys them.
+// storeSBOMs stores the SBOMs of the deployed package in the Zarf registry for 'zarf tools sbom rescan'.
+func (p *Packager) storeSBOMs() {
+	if !p.isConnectedToCluster() || p.cfg.State == nil || p.cfg.State.RegistryInfo.Address == "" || p.cfg.Pkg.Metadata.YOLO {
+		return
+	}
+	if utils.InvalidPath(p.layout.SBOMs.Path) {
+		message.Debugf("%s does not include SBOMs to store in the zarf registry", p.cfg.Pkg.Metadata.Name)
+		return
+	}
+
+	if err := sbom.PushToZarfRegistry(p.cluster, p.layout.SBOMs.Path, p.cfg.Pkg, p.cfg.State.RegistryInfo); err != nil {
+		message.Warnf("Unable to store the SBOMs of %s in the zarf registry, it will not be re-scanned by 'zarf tools sbom rescan': %s", p.cfg.Pkg.Metadata.Name, err.Error())
+	}
+}
+
+// deployComponents deploys the selected ZarfComponents, running independent components in parallel up to the configured concurrency.
 func (p *Packager) deployComponents() (deployedComponents []types.DeployedComponent, err error) {
 	componentsToDeploy := p.getSelectedComponents()
 
@@ -100,50 +158,78 @@ func (p *Packager) deployComponents() (deployedComponents []types.DeployedCompon
 		return deployedComponents, fmt.Errorf("unable to generate the value template: %w", err)
 	}
 
+	// Skip the components an interrupted deployment of this package already deployed
+	var resumedComponents map[string]types.DeployedComponent
+	if p.cfg.DeployOpts.Resume {
+		if resumedComponents, err = p.getResumedComponents(componentsToDeploy); err != nil {
+			return deployedComponents, err
+		}
+	}
+
 	// Check if this package has been deployed before and grab relevant information about already deployed components
 	if p.generation == 0 {
 		p.generation = 1 // If this is the first deployment, set the generation to 1
 	}
 
-	// Process all the components we are deploying
-	for _, component := range componentsToDeploy {
+	concurrency := p.cfg.DeployOpts.Concurrency
+	if p.isInitConfig() {
+		// Init packages rely on each component (i.e. the injector and seed registry) being deployed in order
+		concurrency = 1
+	}
 
-		deployedComponent := types.DeployedComponent{
-			Name:               component.Name,
-			Status:             types.ComponentStatusDeploying,
-			ObservedGeneration: p.generation,
+	// Track components by their position in the package so the package secret lists them in order no matter when they finish
+	startedComponents := make([]*types.DeployedComponent, len(componentsToDeploy))
+	getStartedComponents := func() []types.DeployedComponent {
+		started := []types.DeployedComponent{}
+		for _, deployedComponent := range startedComponents {
+			if deployedComponent != nil {
+				started = append(started, *deployedComponent)
+			}
 		}
+		return started
+	}
 
-		// If this component requires a cluster, connect to one
-		if requiresCluster(component) {
-			timeout := cluster.DefaultTimeout
-			if p.isInitConfig() {
-				timeout = 5 * time.Minute
-			}
+	// Update the package secret with the current status of a component
+	recordComponent := func(idx int, component types.ZarfComponent, deployedComponent types.DeployedComponent) {
+		p.deployMutex.Lock()
+		defer p.deployMutex.Unlock()
 
-			if err := p.connectToCluster(timeout); err != nil {
-				return deployedComponents, fmt.Errorf("unable to connect to the Kubernetes cluster: %w", err)
+		startedComponents[idx] = &deployedComponent
+		if p.isConnectedToCluster() {
+			pkg, deployedComponents := p.withOrphanedComponents(getStartedComponents())
+			if _, err := p.cluster.RecordPackageDeploymentAndWait(pkg, deployedComponents, p.connectStrings, p.deployedVariables, p.generation, component, p.cfg.DeployOpts.SkipWebhooks); err != nil {
+				message.Debugf("Unable to record package deployment for component %q: this will affect features like `zarf package remove`: %s", component.Name, err.Error())
 			}
 		}
+	}
 
-		// Ensure we don't overwrite any installedCharts data when updating the package secret
-		if p.isConnectedToCluster() {
-			deployedComponent.InstalledCharts, err = p.cluster.GetInstalledChartsForComponent(p.cfg.Pkg.Metadata.Name, component)
-			if err != nil {
-				message.Debugf("Unable to fetch installed Helm charts for component '%s': %s", component.Name, err.Error())
-			}
+	// Process all the components we are deploying
+	err = runInDependencyOrder(componentsToDeploy, concurrency, func(idx int, component types.ZarfComponent) (err error) {
+		if resumedComponent, ok := resumedComponents[component.Name]; ok {
+			message.Notef("Skipping component %q as it was already deployed", component.Name)
+			recordComponent(idx, component, resumedComponent)
+			return nil
 		}
 
-		deployedComponents = append(deployedComponents, deployedComponent)
-		idx := len(deployedComponents) - 1
+		finishEvent := message.StartComponent(component.Name)
+		defer func() { finishEvent(err) }()
 
-		// Update the package secret to indicate that we are attempting to deploy this component
-		if p.isConnectedToCluster() {
-			if _, err := p.cluster.RecordPackageDeploymentAndWait(p.cfg.Pkg, deployedComponents, p.connectStrings, p.generation, component, p.cfg.DeployOpts.SkipWebhooks); err != nil {
-				message.Debugf("Unable to record package deployment for component %s: this will affect features like `zarf package remove`: %s", component.Name, err.Error())
-			}
+		deployedComponent := types.DeployedComponent{
+			Name:               component.Name,
+			MirroredRepos:      git.GetMirroredRepos(component.Repos),
+			Status:             types.ComponentStatusDeploying,
+			ObservedGeneration: p.generation,
 		}
 
+		installedCharts, err := p.prepareComponentDeployment(component)
+		if err != nil {
+			return err
+		}
+		deployedComponent.InstalledCharts = installedCharts
+
+		// Update the package secret to indicate that we are attempting to deploy this component
+		recordComponent(idx, component, deployedComponent)
+
 		// Deploy the component
 		var charts []types.InstalledChart
 		var deployErr error
@@ -165,32 +251,65 @@ func (p *Packager) deployComponents() (deployedComponents []types.DeployedCompon
 			onFailure()
 
 			// Update the package secret to indicate that we failed to deploy this component
-			deployedComponents[idx].Status = types.ComponentStatusFailed
-			if p.isConnectedToCluster() {
-				if _, err := p.cluster.RecordPackageDeploymentAndWait(p.cfg.Pkg, deployedComponents, p.connectStrings, p.generation, component, p.cfg.DeployOpts.SkipWebhooks); err != nil {
-					message.Debugf("Unable to record package deployment for component %q: this will affect features like `zarf package remove`: %s", component.Name, err.Error())
-				}
-			}
+			deployedComponent.Status = types.ComponentStatusFailed
+			recordComponent(idx, component, deployedComponent)
 
-			return deployedComponents, fmt.Errorf("unable to deploy component %q: %w", component.Name, deployErr)
+			return fmt.Errorf("unable to deploy component %q: %w", component.Name, deployErr)
 		}
 
-		// Update the package secret to indicate that we successfully deployed this component
-		deployedComponents[idx].InstalledCharts = charts
-		deployedComponents[idx].Status = types.ComponentStatusSucceeded
-		if p.isConnectedToCluster() {
-			if _, err := p.cluster.RecordPackageDeploymentAndWait(p.cfg.Pkg, deployedComponents, p.connectStrings, p.generation, component, p.cfg.DeployOpts.SkipWebhooks); err != nil {
-				message.Debugf("Unable to record package deployment for component %q: this will affect features like `zarf package remove`: %s", component.Name, err.Error())
-			}
+		// Record what was pushed so that `zarf package verify` can check the registry and git server against it
+		deployedComponent.ImageDigests = p.getPackagedImageDigests(component.Images)
+		if componentPaths, ok := p.layout.Components.Dirs[component.Name]; ok && componentPaths != nil {
+			deployedComponent.RepoRefs = git.GetPackagedRefs(componentPaths.Repos, component.Repos)
 		}
 
+		// Update the package secret to indicate that we successfully deployed this component
+		deployedComponent.InstalledCharts = charts
+		deployedComponent.Status = types.ComponentStatusSucceeded
+		recordComponent(idx, component, deployedComponent)
+
 		if err := p.runActions(onDeploy.Defaults, onDeploy.OnSuccess, p.valueTemplate); err != nil {
 			onFailure()
-			return deployedComponents, fmt.Errorf("unable to run component success action: %w", err)
+			return fmt.Errorf("unable to run component success action: %w", err)
+		}
+
+		return nil
+	})
+
+	return getStartedComponents(), err
+}
+
+// prepareComponentDeployment connects to the cluster if a component needs it and returns the charts it already has installed.
+func (p *Packager) prepareComponentDeployment(component types.ZarfComponent) (installedCharts []types.InstalledChart, err error) {
+	p.deployMutex.Lock()
+	defer p.deployMutex.Unlock()
+
+	// If this component requires a cluster, connect to one
+	if requiresCluster(component) {
+		timeout := cluster.DefaultTimeout
+		if p.isInitConfig() {
+			timeout = 5 * time.Minute
+		}
+
+		if err := p.connectToCluster(timeout); err != nil {
+			return nil, fmt.Errorf("unable to connect to the Kubernetes cluster: %w", err)
+		}
+	}
+
+	// Capture the package secret before it is updated so an atomic deployment can restore it
+	if err := p.snapshotDeployedPackage(); err != nil {
+		return nil, err
+	}
+
+	// Ensure we don't overwrite any installedCharts data when updating the package secret
+	if p.isConnectedToCluster() {
+		installedCharts, err = p.cluster.GetInstalledChartsForComponent(p.cfg.Pkg.Metadata.Name, component)
+		if err != nil {
+			message.Debugf("Unable to fetch installed Helm charts for component '%s': %s", component.Name, err.Error())
 		}
 	}
 
-	return deployedComponents, nil
+	return installedCharts, nil
 }
 
 func (p *Packager) deployInitComponent(component types.ZarfComponent) (charts []types.InstalledChart, err error) {
@@ -260,21 +379,10 @@ func (p *Packager) deployComponent(component types.ZarfComponent, noImgChecksum
 
 	onDeploy := component.Actions.OnDeploy
 
-	if !p.valueTemplate.Ready() && requiresCluster(component) {
-		// Setup the state in the config and get the valuesTemplate
-		p.valueTemplate, err = p.setupStateValuesTemplate()
-		if err != nil {
+	if requiresCluster(component) {
+		if err := p.prepareClusterValuesTemplate(hasImages); err != nil {
 			return charts, err
 		}
-
-		// Disable the registry HPA scale down if we are deploying images and it is not already disabled
-		if hasImages && !p.hpaModified && p.cfg.State.RegistryInfo.InternalRegistry {
-			if err := p.cluster.DisableRegHPAScaleDown(); err != nil {
-				message.Debugf("unable to disable the registry HPA scale down: %s", err.Error())
-			} else {
-				p.hpaModified = true
-			}
-		}
 	}
 
 	if err = p.runActions(onDeploy.Defaults, onDeploy.Before, p.valueTemplate); err != nil {
@@ -322,6 +430,31 @@ func (p *Packager) deployComponent(component types.ZarfComponent, noImgChecksum
 	return charts, nil
 }
 
+// prepareClusterValuesTemplate loads the Zarf state into the values template once per deployment.
+func (p *Packager) prepareClusterValuesTemplate(hasImages bool) (err error) {
+	p.deployMutex.Lock()
+	defer p.deployMutex.Unlock()
+
+	if !p.valueTemplate.Ready() {
+		// Setup the state in the config and get the valuesTemplate
+		p.valueTemplate, err = p.setupStateValuesTemplate()
+		if err != nil {
+			return err
+		}
+	}
+
+	// Disable the registry HPA scale down if we are deploying images and it is not already disabled
+	if hasImages && !p.hpaModified && p.cfg.State.RegistryInfo.InternalRegistry {
+		if err := p.cluster.DisableRegHPAScaleDown(); err != nil {
+			message.Debugf("unable to disable the registry HPA scale down: %s", err.Error())
+		} else {
+			p.hpaModified = true
+		}
+	}
+
+	return nil
+}
+
 // Move files onto the host of the machine performing the deployment.
 func (p *Packager) processComponentFiles(component types.ZarfComponent, pkgLocation string) error {
 	spinner := message.NewProgressSpinner("Copying %d files", len(component.Files))
@@ -365,6 +498,11 @@ func (p *Packager) processComponentFiles(component types.ZarfComponent, pkgLocat
 			// If the file is a text file, template it
 			if isText {
 				spinner.Updatef("Templating %s", file.Target)
+				if file.Template == types.GoTemplate {
+					if err := p.valueTemplate.ApplyGoTemplate(component, subFile); err != nil {
+						return fmt.Errorf("unable to template file %s: %w", subFile, err)
+					}
+				}
 				if err := p.valueTemplate.Apply(component, subFile, true); err != nil {
 					return fmt.Errorf("unable to template file %s: %w", subFile, err)
 				}
@@ -448,29 +586,36 @@ func (p *Packager) pushImagesToRegistry(componentImages []string, noImgChecksum
 		return nil
 	}
 
+	imgConfig, err := p.newImageConfig(componentImages, noImgChecksum)
+	if err != nil {
+		return err
+	}
+
+	// Each image is retried on its own with a backoff
+	return imgConfig.PushToZarfRegistry()
+}
+
+// newImageConfig creates the config used to push a components images to the configured container registry.
+func (p *Packager) newImageConfig(componentImages []string, noImgChecksum bool) (images.ImageConfig, error) {
 	var combinedImageList []transform.Image
 	for _, src := range componentImages {
 		ref, err := transform.ParseImageRef(src)
 		if err != nil {
-			return fmt.Errorf("failed to create ref for image %s: %w", src, err)
+			return images.ImageConfig{}, fmt.Errorf("failed to create ref for image %s: %w", src, err)
 		}
 		combinedImageList = append(combinedImageList, ref)
 	}
 
-	imageList := helpers.Unique(combinedImageList)
-
-	imgConfig := images.ImageConfig{
+	return images.ImageConfig{
 		ImagesPath:    p.layout.Images.Base,
-		ImageList:     imageList,
+		ImageList:     helpers.Unique(combinedImageList),
 		NoChecksum:    noImgChecksum,
 		RegInfo:       p.cfg.State.RegistryInfo,
 		Insecure:      config.CommonOptions.Insecure,
 		Architectures: []string{p.cfg.Pkg.Metadata.Architecture, p.cfg.Pkg.Build.Architecture},
-	}
-
-	return helpers.Retry(func() error {
-		return imgConfig.PushToZarfRegistry()
-	}, 3, 5*time.Second, message.Warnf)
+		Concurrency:   p.cfg.DeployOpts.ImagePushConcurrency,
+		VerifyKeys:    p.cfg.DeployOpts.ImageVerifyKeys,
+	}, nil
 }
 
 // Push all of the components git repos to the configured git server.
@@ -512,7 +657,10 @@ func (p *Packager) pushReposToRepository(reposPath string, repos []string) error
 		}
 
 		// Try repo push up to 3 times
-		if err := helpers.Retry(tryPush, 3, 5*time.Second, message.Warnf); err != nil {
+		finishEvent := message.TimeEvent(message.Event{Type: message.EventRepoPushed, Repo: repoURL})
+		err := helpers.Retry(tryPush, 3, 5*time.Second, message.Warnf)
+		finishEvent(err)
+		if err != nil {
 			return fmt.Errorf("unable to push repo %s to the Git Server: %w", repoURL, err)
 		}
 	}
@@ -523,102 +671,145 @@ func (p *Packager) pushReposToRepository(reposPath string, repos []string) error
 // Install all Helm charts and raw k8s manifests into the k8s cluster.
 func (p *Packager) installChartAndManifests(componentPaths *layout.ComponentPaths, component types.ZarfComponent) (installedCharts []types.InstalledChart, err error) {
 	for _, chart := range component.Charts {
-
-		// zarf magic for the value file
-		for idx := range chart.ValuesFiles {
-			chartValueName := fmt.Sprintf("%s-%d", helm.StandardName(componentPaths.Values, chart), idx)
-			if err := p.valueTemplate.Apply(component, chartValueName, false); err != nil {
-				return installedCharts, err
-			}
+		helmCfg, err := p.newChartHelm(componentPaths, component, chart)
+		if err != nil {
+			return installedCharts, err
 		}
 
-		// TODO (@WSTARR): Currently this logic is library-only and is untested while it is in an experimental state - it may eventually get added as shorthand in Zarf Variables though
-		var valuesOverrides map[string]any
-		if componentChartValuesOverrides, ok := p.cfg.DeployOpts.ValuesOverridesMap[component.Name]; ok {
-			if chartValuesOverrides, ok := componentChartValuesOverrides[chart.Name]; ok {
-				valuesOverrides = chartValuesOverrides
-			}
+		if err := p.trackReleaseForRollbackLocked(helmCfg, chart.Namespace); err != nil {
+			return installedCharts, err
 		}
 
-		helmCfg := helm.New(
-			chart,
-			componentPaths.Charts,
-			componentPaths.Values,
-			helm.WithDeployInfo(
-				component,
-				p.cfg,
-				p.cluster,
-				valuesOverrides,
-				p.cfg.DeployOpts.Timeout),
-		)
-
+		finishEvent := message.TimeEvent(message.Event{Type: message.EventChartInstalled, Component: component.Name, Chart: chart.Name, Namespace: chart.Namespace})
 		addedConnectStrings, installedChartName, err := helmCfg.InstallOrUpgradeChart()
+		finishEvent(err)
 		if err != nil {
 			return installedCharts, err
 		}
 		installedCharts = append(installedCharts, types.InstalledChart{Namespace: chart.Namespace, ChartName: installedChartName})
 
-		// Iterate over any connectStrings and add to the main map
-		for name, description := range addedConnectStrings {
-			p.connectStrings[name] = description
-		}
+		p.addConnectStrings(addedConnectStrings)
 	}
 
 	for _, manifest := range component.Manifests {
-		for idx := range manifest.Files {
-			if utils.InvalidPath(filepath.Join(componentPaths.Manifests, manifest.Files[idx])) {
-				// The path is likely invalid because of how we compose OCI components, add an index suffix to the filename
-				manifest.Files[idx] = fmt.Sprintf("%s-%d.yaml", manifest.Name, idx)
-				if utils.InvalidPath(filepath.Join(componentPaths.Manifests, manifest.Files[idx])) {
-					return installedCharts, fmt.Errorf("unable to find manifest file %s", manifest.Files[idx])
-				}
-			}
-		}
-		// Move kustomizations to files now
-		for idx := range manifest.Kustomizations {
-			kustomization := fmt.Sprintf("kustomization-%s-%d.yaml", manifest.Name, idx)
-			manifest.Files = append(manifest.Files, kustomization)
-		}
-
-		if manifest.Namespace == "" {
-			// Helm gets sad when you don't provide a namespace even though we aren't using helm templating
-			manifest.Namespace = corev1.NamespaceDefault
-		}
-
-		// Create a chart and helm cfg from a given Zarf Manifest.
-		helmCfg, err := helm.NewFromZarfManifest(
-			manifest,
-			componentPaths.Manifests,
-			p.cfg.Pkg.Metadata.Name,
-			component.Name,
-			helm.WithDeployInfo(
-				component,
-				p.cfg,
-				p.cluster,
-				nil,
-				p.cfg.DeployOpts.Timeout),
-		)
+		helmCfg, err := p.newManifestHelm(componentPaths, component, manifest)
 		if err != nil {
 			return installedCharts, err
 		}
 
+		if err := p.trackReleaseForRollbackLocked(helmCfg, helmCfg.Namespace()); err != nil {
+			return installedCharts, err
+		}
+
 		// Install the chart.
+		finishEvent := message.TimeEvent(message.Event{Type: message.EventChartInstalled, Component: component.Name, Chart: manifest.Name, Namespace: helmCfg.Namespace()})
 		addedConnectStrings, installedChartName, err := helmCfg.InstallOrUpgradeChart()
+		finishEvent(err)
 		if err != nil {
 			return installedCharts, err
 		}
 
-		installedCharts = append(installedCharts, types.InstalledChart{Namespace: manifest.Namespace, ChartName: installedChartName})
+		installedCharts = append(installedCharts, types.InstalledChart{Namespace: helmCfg.Namespace(), ChartName: installedChartName})
 
-		// Iterate over any connectStrings and add to the main map
-		for name, description := range addedConnectStrings {
-			p.connectStrings[name] = description
-		}
+		p.addConnectStrings(addedConnectStrings)
 	}
 
 	return installedCharts, nil
 }
 
+// newChartHelm templates the values files of a Zarf chart and creates the helm config used to deploy it.
+func (p *Packager) newChartHelm(componentPaths *layout.ComponentPaths, component types.ZarfComponent, chart types.ZarfChart) (*helm.Helm, error) {
+	// zarf magic for the value file
+	for idx := range chart.ValuesFiles {
+		chartValueName := fmt.Sprintf("%s-%d", helm.StandardName(componentPaths.Values, chart), idx)
+		if err := p.valueTemplate.Apply(component, chartValueName, false); err != nil {
+			return nil, err
+		}
+	}
+
+	// Set the values the chart maps from variables over its values files
+	valuesOverrides, err := p.getChartVariableValues(chart)
+	if err != nil {
+		return nil, err
+	}
+
+	return helm.New(
+		chart,
+		componentPaths.Charts,
+		componentPaths.Values,
+		helm.WithDeployInfo(
+			component,
+			p.cfg,
+			p.cluster,
+			valuesOverrides,
+			p.cfg.DeployOpts.Timeout),
+	), nil
+}
+
+// newManifestHelm creates the helm config used to deploy a Zarf manifest as a chart.
+func (p *Packager) newManifestHelm(componentPaths *layout.ComponentPaths, component types.ZarfComponent, manifest types.ZarfManifest) (*helm.Helm, error) {
+	for idx := range manifest.Files {
+		if utils.InvalidPath(filepath.Join(componentPaths.Manifests, manifest.Files[idx])) {
+			// The path is likely invalid because of how we compose OCI components, add an index suffix to the filename
+			manifest.Files[idx] = fmt.Sprintf("%s-%d.yaml", manifest.Name, idx)
+			if utils.InvalidPath(filepath.Join(componentPaths.Manifests, manifest.Files[idx])) {
+				return nil, fmt.Errorf("unable to find manifest file %s", manifest.Files[idx])
+			}
+		}
+	}
+	// Move kustomizations to files now
+	for idx := range manifest.Kustomizations {
+		kustomization := fmt.Sprintf("kustomization-%s-%d.yaml", manifest.Name, idx)
+		manifest.Files = append(manifest.Files, kustomization)
+	}
+
+	// Render Go templates now, the ###ZARF_ templates are replaced as the chart is post-rendered
+	if manifest.Template == types.GoTemplate {
+		for _, file := range manifest.Files {
+			if err := p.valueTemplate.ApplyGoTemplate(component, filepath.Join(componentPaths.Manifests, file)); err != nil {
+				return nil, fmt.Errorf("unable to template manifest file %s: %w", file, err)
+			}
+		}
+	}
+
+	if manifest.Namespace == "" {
+		// Helm gets sad when you don't provide a namespace even though we aren't using helm templating
+		manifest.Namespace = corev1.NamespaceDefault
+	}
+
+	// Create a chart and helm cfg from a given Zarf Manifest.
+	return helm.NewFromZarfManifest(
+		manifest,
+		componentPaths.Manifests,
+		p.cfg.Pkg.Metadata.Name,
+		component.Name,
+		helm.WithDeployInfo(
+			component,
+			p.cfg,
+			p.cluster,
+			nil,
+			p.cfg.DeployOpts.Timeout),
+	)
+}
+
+// addConnectStrings adds the connectStrings of a chart to the main map.
+func (p *Packager) addConnectStrings(connectStrings types.ConnectStrings) {
+	p.deployMutex.Lock()
+	defer p.deployMutex.Unlock()
+
+	for name, description := range connectStrings {
+		p.connectStrings[name] = description
+	}
+}
+
+// trackReleaseForRollbackLocked tracks a release for rollback while other components may be deploying.
+func (p *Packager) trackReleaseForRollbackLocked(helmCfg *helm.Helm, namespace string) error {
+	p.deployMutex.Lock()
+	defer p.deployMutex.Unlock()
+
+	return p.trackReleaseForRollback(helmCfg, namespace)
+}
+
 func (p *Packager) printTablesForDeployment(componentsToDeploy []types.DeployedComponent) {
 
 	// If not init config, print the application connection table
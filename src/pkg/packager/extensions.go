@@ -5,9 +5,7 @@
 package packager
 
 import (
-	"fmt"
-
-	"github.com/defenseunicorns/zarf/src/extensions/bigbang"
+	"github.com/defenseunicorns/zarf/src/extensions"
 	"github.com/defenseunicorns/zarf/src/types"
 )
 
@@ -22,11 +20,8 @@ func (p *Packager) processExtensions() (err error) {
 			return err
 		}
 
-		// Big Bang
-		if c.Extensions.BigBang != nil {
-			if c, err = bigbang.Run(p.cfg.Pkg.Metadata.YOLO, componentPaths, c); err != nil {
-				return fmt.Errorf("unable to process bigbang extension: %w", err)
-			}
+		if c, err = extensions.Run(p.cfg.Pkg.Metadata.YOLO, componentPaths, c); err != nil {
+			return err
 		}
 
 		components = append(components, c)
@@ -50,11 +45,8 @@ func (p *Packager) skeletonizeExtensions() (err error) {
 			return err
 		}
 
-		// Big Bang
-		if c.Extensions.BigBang != nil {
-			if c, err = bigbang.Skeletonize(componentPaths, c); err != nil {
-				return fmt.Errorf("unable to process bigbang extension: %w", err)
-			}
+		if c, err = extensions.Skeletonize(componentPaths, c); err != nil {
+			return err
 		}
 
 		components = append(components, c)
@@ -5,13 +5,15 @@
 package packager
 
 import (
+	"path/filepath"
+
 	"github.com/defenseunicorns/zarf/src/internal/packager/sbom"
 	"github.com/defenseunicorns/zarf/src/pkg/utils"
 )
 
 // Inspect list the contents of a package.
 func (p *Packager) Inspect() (err error) {
-	wantSBOM := p.cfg.InspectOpts.ViewSBOM || p.cfg.InspectOpts.SBOMOutputDir != ""
+	wantSBOM := p.cfg.InspectOpts.ViewSBOM || p.cfg.InspectOpts.SBOMOutputDir != "" || p.cfg.InspectOpts.ViewVulns
 
 	if err = p.source.LoadPackageMetadata(p.layout, wantSBOM, true); err != nil {
 		return err
@@ -25,12 +27,27 @@ func (p *Packager) Inspect() (err error) {
 
 	sbomDir := p.layout.SBOMs.Path
 
+	if p.cfg.InspectOpts.ViewVulns {
+		reports, err := sbom.ReadScanReports(sbomDir)
+		if err != nil {
+			return err
+		}
+		sbom.PrintScanReports(reports, "")
+	}
+
 	if p.cfg.InspectOpts.SBOMOutputDir != "" {
 		out, err := sbom.OutputSBOMFiles(sbomDir, p.cfg.InspectOpts.SBOMOutputDir, p.cfg.Pkg.Metadata.Name)
 		if err != nil {
 			return err
 		}
 		sbomDir = out
+
+		// Packages created by older versions of Zarf only include Syft JSON SBOMs
+		if utils.InvalidPath(filepath.Join(sbomDir, sbom.SPDXDir)) {
+			if err := sbom.ExportSBOMs(sbomDir, p.cfg.Pkg); err != nil {
+				return err
+			}
+		}
 	}
 
 	if p.cfg.InspectOpts.ViewSBOM {
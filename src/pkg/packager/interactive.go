@@ -25,7 +25,7 @@ func (p *Packager) confirmAction(stage string) (confirm bool) {
 	utils.ColorPrintYAML(p.cfg.Pkg, p.getPackageYAMLHints(stage), true)
 
 	// Print any potential breaking changes (if this is a Deploy confirm) between this CLI version and the deployed init package
-	if stage == config.ZarfDeployStage {
+	if stage == config.ZarfDeployStage || stage == config.ZarfUpgradeStage {
 		if sbom.IsSBOMAble(p.cfg.Pkg) {
 			// Print the location that the user can view the package SBOMs from
 			message.HorizontalRule()
@@ -62,13 +62,18 @@ func (p *Packager) confirmAction(stage string) (confirm bool) {
 		}
 	}
 
+	// Print the components an upgrade will remove from the cluster
+	if stage == config.ZarfUpgradeStage {
+		p.printOrphanedComponents()
+	}
+
 	message.HorizontalRule()
 
 	// Display prompt if not auto-confirmed
-	if config.CommonOptions.Confirm {
+	if config.CommonOptions.Confirm || p.confirmed {
 		pterm.Println()
 		message.Successf("%s Zarf package confirmed", stage)
-		return config.CommonOptions.Confirm
+		return true
 	}
 
 	prompt := &survey.Confirm{
@@ -89,7 +94,7 @@ func (p *Packager) confirmAction(stage string) (confirm bool) {
 func (p *Packager) getPackageYAMLHints(stage string) map[string]string {
 	hints := map[string]string{}
 
-	if stage == config.ZarfDeployStage {
+	if stage == config.ZarfDeployStage || stage == config.ZarfUpgradeStage {
 		for _, variable := range p.cfg.Pkg.Variables {
 			value, present := p.cfg.PkgOpts.SetVariables[variable.Name]
 			if !present {
@@ -14,6 +14,7 @@ import (
 
 	"github.com/defenseunicorns/zarf/src/config"
 	"github.com/defenseunicorns/zarf/src/config/lang"
+	"github.com/defenseunicorns/zarf/src/internal/packager/variables"
 	"github.com/defenseunicorns/zarf/src/pkg/layout"
 	"github.com/defenseunicorns/zarf/src/pkg/packager"
 	"github.com/defenseunicorns/zarf/src/pkg/packager/composer"
@@ -53,6 +54,8 @@ func Validate(createOpts types.ZarfCreateOptions) (*Validator, error) {
 
 	lintComponents(&validator, &createOpts)
 
+	checkVariableDefaults(&validator)
+
 	if validator.jsonSchema, err = getSchemaFile(); err != nil {
 		return nil, err
 	}
@@ -233,6 +236,37 @@ func checkForUnpinnedFiles(validator *Validator, node *composer.Node) {
 	}
 }
 
+func checkVariableDefaults(validator *Validator) {
+	for i, variable := range validator.typedZarfPackage.Variables {
+		yqPath := fmt.Sprintf(".variables.[%d]", i)
+		if err := variables.ValidateDefinition(variable); err != nil {
+			validator.addError(validatorMessage{
+				yqPath:         yqPath,
+				packageRelPath: ".",
+				packageName:    validator.typedZarfPackage.Metadata.Name,
+				description:    fmt.Sprintf(lang.InvalidVarDefinitionLintError, err.Error()),
+				item:           variable.Name,
+			})
+			continue
+		}
+
+		// Defaults set from package templates are not known until create
+		if strings.Contains(variable.Default, types.ZarfPackageTemplatePrefix) ||
+			strings.Contains(variable.Default, types.ZarfPackageVariablePrefix) {
+			continue
+		}
+		if err := variables.Validate(variable, variable.Default); err != nil {
+			validator.addError(validatorMessage{
+				yqPath:         yqPath + ".default",
+				packageRelPath: ".",
+				packageName:    validator.typedZarfPackage.Metadata.Name,
+				description:    fmt.Sprintf(lang.InvalidVarDefaultLintError, variable.Type, err.Error()),
+				item:           variable.Name,
+			})
+		}
+	}
+}
+
 func checkForVarInComponentImport(validator *Validator, node *composer.Node) {
 	if strings.Contains(node.Import.Path, types.ZarfPackageTemplatePrefix) {
 		validator.addWarning(validatorMessage{
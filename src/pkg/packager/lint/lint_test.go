@@ -159,6 +159,20 @@ func TestValidateSchema(t *testing.T) {
 		require.Equal(t, 1, len(validator.findings))
 	})
 
+	t.Run("Variable default type error", func(t *testing.T) {
+		maxReplicas := 5
+		validator := Validator{typedZarfPackage: types.ZarfPackage{Variables: []types.ZarfPackageVariable{
+			{Name: "REPLICAS", Type: types.IntVariableType, Max: &maxReplicas, Default: "10"},
+			{Name: "LEVEL", Type: types.EnumVariableType, Default: "info"},
+			{Name: "DOMAIN", Type: types.HostnameVariableType, Default: "###ZARF_PKG_TMPL_DOMAIN###"},
+			{Name: "ENABLED", Type: types.BoolVariableType, Default: "true"},
+		}}}
+		checkVariableDefaults(&validator)
+		require.Equal(t, 2, len(validator.findings))
+		require.Equal(t, ".variables.[0].default", validator.findings[0].yqPath)
+		require.Equal(t, ".variables.[1]", validator.findings[1].yqPath)
+	})
+
 	t.Run("Wrap standalone numbers in bracket", func(t *testing.T) {
 		input := "components12.12.import.path"
 		expected := ".components12.[12].import.path"
@@ -15,6 +15,9 @@ import (
 
 // Mirror pulls resources from a package (images, git repositories, etc) and pushes them to remotes in the air gap without deploying them
 func (p *Packager) Mirror() (err error) {
+	finishEvent := message.StartOperation("mirror", p.cfg.PkgOpts.PackageSource)
+	defer func() { finishEvent(err) }()
+
 	spinner := message.NewProgressSpinner("Mirroring Zarf package %s", p.cfg.PkgOpts.PackageSource)
 	defer spinner.Stop()
 
@@ -48,7 +51,10 @@ func (p *Packager) Mirror() (err error) {
 }
 
 // mirrorComponent mirrors a Zarf Component.
-func (p *Packager) mirrorComponent(component types.ZarfComponent) error {
+func (p *Packager) mirrorComponent(component types.ZarfComponent) (err error) {
+	finishEvent := message.StartComponent(component.Name)
+	defer func() { finishEvent(err) }()
+
 	componentPaths := p.layout.Components.Dirs[component.Name]
 
 	// All components now require a name
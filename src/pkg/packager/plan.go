@@ -0,0 +1,346 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package packager contains functions for interacting with, managing and deploying Zarf packages.
+package packager
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/defenseunicorns/zarf/src/config/lang"
+	"github.com/defenseunicorns/zarf/src/internal/packager/helm"
+	"github.com/defenseunicorns/zarf/src/internal/packager/template"
+	"github.com/defenseunicorns/zarf/src/pkg/cluster"
+	"github.com/defenseunicorns/zarf/src/pkg/k8s"
+	"github.com/defenseunicorns/zarf/src/pkg/message"
+	"github.com/defenseunicorns/zarf/src/pkg/transform"
+	"github.com/defenseunicorns/zarf/src/pkg/utils/helpers"
+	"github.com/defenseunicorns/zarf/src/types"
+	"helm.sh/helm/v3/pkg/releaseutil"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+)
+
+// The actions a plan can report for a resource.
+const (
+	planActionAdd    = "add"
+	planActionChange = "change"
+	planActionRemove = "remove"
+)
+
+// planResource is a single resource rendered by a Helm release.
+type planResource struct {
+	namespace string
+	object    *unstructured.Unstructured
+}
+
+// planChange is a resource a deployment would add, change or remove.
+type planChange struct {
+	action   string
+	resource planResource
+	details  string
+}
+
+// plan prints the changes a deployment of this package would make to the cluster without making them.
+func (p *Packager) plan() error {
+	if p.isInitConfig() {
+		return fmt.Errorf(lang.PkgDeployErrPlanInitPackage)
+	}
+
+	// Track the variables that a real deployment would prompt for, the plan uses their defaults instead
+	promptedVariables := []types.ZarfPackageVariable{}
+	for _, variable := range p.cfg.Pkg.Variables {
+		if _, present := p.cfg.PkgOpts.SetVariables[variable.Name]; !present && variable.Prompt {
+			promptedVariables = append(promptedVariables, variable)
+		}
+	}
+
+	if err := p.connectToCluster(cluster.DefaultTimeout); err != nil {
+		return fmt.Errorf("unable to connect to the Kubernetes cluster: %w", err)
+	}
+
+	// Plan with the variables the previous deployment of the package used, as a deployment would
+	if err := p.loadPreviousVariables(); err != nil {
+		return err
+	}
+
+	if err := p.setVariableMapInConfig(); err != nil {
+		return fmt.Errorf("unable to set the active variables: %w", err)
+	}
+	deployedVariables, err := p.getDeployedVariables()
+	if err != nil {
+		return err
+	}
+	p.deployedVariables = deployedVariables
+
+	// Load the state without creating anything so the cluster is left untouched
+	state, err := p.cluster.LoadZarfState()
+	if err != nil && !p.cfg.Pkg.Metadata.YOLO {
+		return fmt.Errorf("%s %w", lang.ErrLoadState, err)
+	} else if state == nil && p.cfg.Pkg.Metadata.YOLO {
+		state = &types.ZarfState{Distro: "YOLO"}
+	}
+	p.cfg.State = state
+
+	if p.valueTemplate, err = template.Generate(p.cfg); err != nil {
+		return fmt.Errorf("unable to generate the value template: %w", err)
+	}
+
+	deployedPackage, err := p.cluster.GetDeployedPackage(p.cfg.Pkg.Metadata.Name)
+	if err != nil && !kerrors.IsNotFound(err) {
+		return fmt.Errorf("unable to get the deployed package %s: %w", p.cfg.Pkg.Metadata.Name, err)
+	}
+
+	message.HeaderInfof("📋 DEPLOYMENT PLAN FOR %s", strings.ToUpper(p.cfg.Pkg.Metadata.Name))
+
+	if deployedPackage == nil {
+		message.Notef("Package %q has not been deployed to this cluster before", p.cfg.Pkg.Metadata.Name)
+	} else {
+		message.Notef("Package %q is deployed at generation %d and would be upgraded to generation %d", p.cfg.Pkg.Metadata.Name, deployedPackage.Generation, deployedPackage.Generation+1)
+	}
+
+	// Discover the API resources of the cluster once for every live object the plan compares against
+	getter, err := p.cluster.NewResourceGetter()
+	if err != nil {
+		return fmt.Errorf("unable to discover the resources of the cluster: %w", err)
+	}
+
+	for _, component := range p.getSelectedComponents() {
+		if err := p.planComponent(component, deployedPackage, getter); err != nil {
+			return fmt.Errorf("unable to plan component %q: %w", component.Name, err)
+		}
+	}
+
+	if len(promptedVariables) > 0 {
+		message.HeaderInfof("🔤 PROMPTED VARIABLES")
+		message.Note("These variables would be prompted for during deployment, the plan used the values below")
+
+		variableData := [][]string{}
+		for _, variable := range promptedVariables {
+			value := p.cfg.SetVariableMap[variable.Name].Value
+			if variable.Sensitive {
+				value = "**sanitized**"
+			}
+			variableData = append(variableData, []string{variable.Name, value, variable.Description})
+		}
+		message.Table([]string{"Variable", "Value", "Description"}, variableData)
+	}
+
+	p.printVariableChanges()
+
+	return nil
+}
+
+// planComponent prints the images, repos and resources a deployment of a component would change.
+func (p *Packager) planComponent(component types.ZarfComponent, deployedPackage *types.DeployedPackage, getter *k8s.ResourceGetter) error {
+	componentPaths := p.layout.Components.Dirs[component.Name]
+
+	message.HeaderInfof("📦 %s COMPONENT", strings.ToUpper(component.Name))
+
+	var deployedComponent *types.DeployedComponent
+	if deployedPackage != nil {
+		for idx := range deployedPackage.DeployedComponents {
+			if deployedPackage.DeployedComponents[idx].Name == component.Name {
+				deployedComponent = &deployedPackage.DeployedComponents[idx]
+			}
+		}
+	}
+
+	if deployedComponent == nil {
+		message.Note("This component has not been deployed to this cluster before")
+	} else {
+		message.Notef("This component is currently %s at generation %d", strings.ToLower(string(deployedComponent.Status)), deployedComponent.ObservedGeneration)
+	}
+
+	if len(component.Actions.OnDeploy.Before) > 0 || len(component.Actions.OnDeploy.After) > 0 || len(component.Files) > 0 || len(component.DataInjections) > 0 {
+		message.Warn("This component has actions, files or data injections that are not evaluated by a plan")
+	}
+
+	if len(component.Images) > 0 {
+		imageData := [][]string{}
+		for _, image := range helpers.Unique(component.Images) {
+			target, err := transform.ImageTransformHost(p.cfg.State.RegistryInfo.Address, image)
+			if err != nil {
+				return fmt.Errorf("unable to transform the image %s: %w", image, err)
+			}
+			imageData = append(imageData, []string{image, target})
+		}
+		message.Table([]string{"Image", "Pushed To"}, imageData)
+	}
+
+	if len(component.Repos) > 0 {
+		repoData := [][]string{}
+		for _, repo := range component.Repos {
+			target, err := transform.GitURL(p.cfg.State.GitServer.Address, repo, p.cfg.State.GitServer.PushUsername)
+			if err != nil {
+				return fmt.Errorf("unable to transform the repo %s: %w", repo, err)
+			}
+			repoData = append(repoData, []string{repo, target.String()})
+		}
+		message.Table([]string{"Repository", "Pushed To"}, repoData)
+	}
+
+	releases := []*helm.Helm{}
+	for _, chart := range component.Charts {
+		helmCfg, err := p.newChartHelm(componentPaths, component, chart)
+		if err != nil {
+			return err
+		}
+		releases = append(releases, helmCfg)
+	}
+	for _, manifest := range component.Manifests {
+		helmCfg, err := p.newManifestHelm(componentPaths, component, manifest)
+		if err != nil {
+			return err
+		}
+		releases = append(releases, helmCfg)
+	}
+
+	resourceData := [][]string{}
+	plannedReleases := map[string]bool{}
+	for _, helmCfg := range releases {
+		plannedReleases[helmCfg.Namespace()+"/"+helmCfg.ReleaseName()] = true
+
+		renderedManifest, deployedManifest, err := helmCfg.PlanChart()
+		if err != nil {
+			return err
+		}
+
+		rendered, err := parsePlanResources(renderedManifest, helmCfg.Namespace())
+		if err != nil {
+			return fmt.Errorf("unable to parse the rendered resources of %s: %w", helmCfg.ReleaseName(), err)
+		}
+		previous, err := parsePlanResources(deployedManifest, helmCfg.Namespace())
+		if err != nil {
+			return fmt.Errorf("unable to parse the deployed resources of %s: %w", helmCfg.ReleaseName(), err)
+		}
+
+		getLive := func(resource planResource) *unstructured.Unstructured {
+			return planLiveResource(getter, resource)
+		}
+		for _, change := range diffPlanResources(previous, rendered, getLive, p.isAgentMutation) {
+			resourceData = append(resourceData, []string{
+				change.action,
+				change.resource.object.GetKind(),
+				change.resource.namespace,
+				change.resource.object.GetName(),
+				helmCfg.ReleaseName(),
+				message.Truncate(change.details, 60, false),
+			})
+		}
+	}
+
+	// Releases from the last deployment of this component that are no longer in it are left in the cluster
+	if deployedComponent != nil {
+		for _, chart := range deployedComponent.InstalledCharts {
+			if !plannedReleases[chart.Namespace+"/"+chart.ChartName] {
+				message.Warnf("Helm release %s/%s is no longer part of this component and would be left in the cluster", chart.Namespace, chart.ChartName)
+			}
+		}
+	}
+
+	if len(releases) > 0 {
+		if len(resourceData) == 0 {
+			message.Note("No resources would change")
+		} else {
+			message.Table([]string{"Action", "Kind", "Namespace", "Name", "Release", "Details"}, resourceData)
+		}
+	}
+
+	return nil
+}
+
+// planLiveResource returns the live object of a resource in the cluster, or nil if it does not exist.
+func planLiveResource(getter *k8s.ResourceGetter, resource planResource) *unstructured.Unstructured {
+	live, err := getter.Get(resource.namespace, resource.object.GetName(), resource.object.GroupVersionKind().GroupKind())
+	if err != nil {
+		// Resources whose kind is not yet known to the cluster (i.e. new CRDs) do not exist either
+		if !kerrors.IsNotFound(err) {
+			message.Debugf("Unable to get %s %s/%s: %s", resource.object.GetKind(), resource.namespace, resource.object.GetName(), err.Error())
+		}
+		return nil
+	}
+	return live
+}
+
+// parsePlanResources parses a Helm manifest into resources keyed by kind, namespace and name.
+func parsePlanResources(manifest string, defaultNamespace string) (map[string]planResource, error) {
+	resources := map[string]planResource{}
+
+	for _, content := range releaseutil.SplitManifests(manifest) {
+		object := &unstructured.Unstructured{}
+		if err := yaml.Unmarshal([]byte(content), object); err != nil {
+			return nil, err
+		}
+
+		// Skip documents that only contain comments or whitespace
+		if object.GetKind() == "" {
+			continue
+		}
+
+		namespace := object.GetNamespace()
+		if namespace == "" {
+			namespace = defaultNamespace
+		}
+
+		key := fmt.Sprintf("%s/%s/%s", object.GetKind(), namespace, object.GetName())
+		resources[key] = planResource{namespace: namespace, object: object}
+	}
+
+	return resources, nil
+}
+
+// diffPlanResources compares the newly rendered resources with their live objects in the cluster and the last deployed release.
+// Fields that only the live object has (defaults, server-managed metadata, etc) and values that isMutation accepts are not changes.
+func diffPlanResources(previous, rendered map[string]planResource, getLive func(planResource) *unstructured.Unstructured, isMutation func(string, string) bool) []planChange {
+	changes := []planChange{}
+
+	for key, resource := range rendered {
+		previousResource, wasDeployed := previous[key]
+
+		live := getLive(resource)
+		if live == nil {
+			changes = append(changes, planChange{action: planActionAdd, resource: resource})
+			continue
+		}
+
+		if !wasDeployed {
+			// Resources outside of the last release that already exist would be taken over
+			changes = append(changes, planChange{action: planActionChange, resource: resource, details: "adopted into the release"})
+			continue
+		}
+
+		if drift := diffLiveObject(comparableObject(resource.object), live.Object, "", isMutation); len(drift) > 0 {
+			changes = append(changes, planChange{action: planActionChange, resource: resource, details: strings.Join(drift, ", ")})
+			continue
+		}
+
+		// Fields the new release no longer sets (or that were changed in the cluster to the new values) differ from the last release
+		if !reflect.DeepEqual(previousResource.object.Object, resource.object.Object) {
+			changes = append(changes, planChange{action: planActionChange, resource: resource, details: "changed since the last release"})
+		}
+	}
+
+	for key, resource := range previous {
+		if _, stillRendered := rendered[key]; !stillRendered {
+			changes = append(changes, planChange{action: planActionRemove, resource: resource})
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		a, b := changes[i].resource, changes[j].resource
+		if a.object.GetKind() != b.object.GetKind() {
+			return a.object.GetKind() < b.object.GetKind()
+		}
+		if a.namespace != b.namespace {
+			return a.namespace < b.namespace
+		}
+		return a.object.GetName() < b.object.GetName()
+	})
+
+	return changes
+}
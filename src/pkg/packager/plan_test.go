@@ -0,0 +1,183 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package packager contains functions for interacting with, managing and deploying Zarf packages.
+package packager
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// TestDiffPlanResources verifies that Zarf reports added, changed and removed resources against the live cluster and the last release.
+func TestDiffPlanResources(t *testing.T) {
+	t.Parallel()
+
+	deployedManifest := `---
+# Source: podinfo/templates/service.yaml
+apiVersion: v1
+kind: Service
+metadata:
+  name: podinfo
+spec:
+  ports:
+    - port: 9898
+---
+# Source: podinfo/templates/deployment.yaml
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: podinfo
+  namespace: podinfo
+spec:
+  replicas: 1
+---
+# Source: podinfo/templates/configmap.yaml
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: removed
+---
+# Source: podinfo/templates/unchanged.yaml
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: unchanged
+data:
+  key: value
+`
+
+	renderedManifest := `---
+# Source: podinfo/templates/service.yaml
+apiVersion: v1
+kind: Service
+metadata:
+  name: podinfo
+spec:
+  ports:
+    - port: 9898
+---
+# Source: podinfo/templates/deployment.yaml
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: podinfo
+  namespace: podinfo
+spec:
+  replicas: 2
+---
+# Source: podinfo/templates/secret.yaml
+apiVersion: v1
+kind: Secret
+metadata:
+  name: new
+---
+# Source: podinfo/templates/serviceaccount.yaml
+apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: existing
+---
+# Source: podinfo/templates/unchanged.yaml
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: unchanged
+data:
+  key: value
+`
+
+	liveManifest := `---
+apiVersion: v1
+kind: Service
+metadata:
+  name: podinfo
+  namespace: podinfo
+  uid: 1234
+  resourceVersion: "42"
+spec:
+  clusterIP: 10.0.0.10
+  ports:
+    - port: 8080
+      protocol: TCP
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: podinfo
+  namespace: podinfo
+spec:
+  replicas: 1
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: removed
+  namespace: podinfo
+---
+apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: existing
+  namespace: podinfo
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: unchanged
+  namespace: podinfo
+data:
+  key: value
+`
+
+	previous, err := parsePlanResources(deployedManifest, "podinfo")
+	require.NoError(t, err)
+	require.Len(t, previous, 4)
+
+	rendered, err := parsePlanResources(renderedManifest, "podinfo")
+	require.NoError(t, err)
+	require.Len(t, rendered, 5)
+
+	live, err := parsePlanResources(liveManifest, "podinfo")
+	require.NoError(t, err)
+
+	getLive := func(resource planResource) *unstructured.Unstructured {
+		key := fmt.Sprintf("%s/%s/%s", resource.object.GetKind(), resource.namespace, resource.object.GetName())
+		if liveResource, ok := live[key]; ok {
+			return liveResource.object
+		}
+		return nil
+	}
+	noMutation := func(string, string) bool { return false }
+
+	type expectedChange struct {
+		action  string
+		kind    string
+		name    string
+		details string
+	}
+
+	expected := []expectedChange{
+		{action: planActionRemove, kind: "ConfigMap", name: "removed"},
+		{action: planActionChange, kind: "Deployment", name: "podinfo", details: "spec.replicas"},
+		{action: planActionAdd, kind: "Secret", name: "new"},
+		{action: planActionChange, kind: "Service", name: "podinfo", details: "spec.ports[0].port"},
+		{action: planActionChange, kind: "ServiceAccount", name: "existing", details: "adopted into the release"},
+	}
+
+	actual := []expectedChange{}
+	for _, change := range diffPlanResources(previous, rendered, getLive, noMutation) {
+		require.Equal(t, "podinfo", change.resource.namespace)
+		actual = append(actual, expectedChange{
+			action:  change.action,
+			kind:    change.resource.object.GetKind(),
+			name:    change.resource.object.GetName(),
+			details: change.details,
+		})
+	}
+
+	require.Equal(t, expected, actual)
+}
@@ -6,13 +6,19 @@ package packager
 
 import (
 	"fmt"
+	"io/fs"
 	"os"
+	"path"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"strings"
 
 	"github.com/defenseunicorns/zarf/src/config"
 	"github.com/defenseunicorns/zarf/src/config/lang"
+	"github.com/defenseunicorns/zarf/src/extensions"
+	"github.com/defenseunicorns/zarf/src/internal/packager/flux"
+	"github.com/defenseunicorns/zarf/src/internal/packager/git"
 	"github.com/defenseunicorns/zarf/src/internal/packager/helm"
 	"github.com/defenseunicorns/zarf/src/internal/packager/kustomize"
 	"github.com/defenseunicorns/zarf/src/pkg/k8s"
@@ -21,7 +27,10 @@ import (
 	"github.com/defenseunicorns/zarf/src/pkg/utils"
 	"github.com/defenseunicorns/zarf/src/pkg/utils/helpers"
 	"github.com/defenseunicorns/zarf/src/types"
+	fluxHelmCtrl "github.com/fluxcd/helm-controller/api/v2beta1"
+	fluxSrcCtrl "github.com/fluxcd/source-controller/api/v1beta2"
 	"github.com/google/go-containerregistry/pkg/crane"
+	"helm.sh/helm/v3/pkg/registry"
 	v1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -36,6 +45,7 @@ func (p *Packager) FindImages() (imgMap map[string][]string, err error) {
 	imagesMap := make(map[string][]string)
 	erroredCharts := []string{}
 	erroredCosignLookups := []string{}
+	erroredFluxResources := []string{}
 
 	cwd, err := os.Getwd()
 	if err != nil {
@@ -77,7 +87,7 @@ func (p *Packager) FindImages() (imgMap map[string][]string, err error) {
 
 	for _, component := range p.cfg.Pkg.Components {
 
-		if len(component.Charts)+len(component.Manifests)+len(component.Repos) < 1 {
+		if len(component.Charts)+len(component.Manifests)+len(component.Repos) < 1 && !extensions.Configured(component) {
 			// Skip if it doesn't have what we need
 			continue
 		}
@@ -116,6 +126,14 @@ func (p *Packager) FindImages() (imgMap map[string][]string, err error) {
 			return nil, err
 		}
 
+		extensionImages, err := extensions.FindImages(componentPaths, component)
+		if err != nil {
+			return nil, err
+		}
+		for _, image := range extensionImages {
+			matchedImages[image] = true
+		}
+
 		for _, chart := range component.Charts {
 			helmCfg := helm.New(
 				chart,
@@ -191,6 +209,17 @@ func (p *Packager) FindImages() (imgMap map[string][]string, err error) {
 			}
 		}
 
+		// Follow any Flux objects to the charts and kustomizations they deploy
+		fluxResources, fluxImages, erroredFlux, err := findFluxResources(resources, filepath.Join(componentPaths.Temp, "flux"), kubeVersionOverride)
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, fluxResources...)
+		for _, image := range fluxImages {
+			matchedImages[image] = true
+		}
+		erroredFluxResources = append(erroredFluxResources, erroredFlux...)
+
 		spinner := message.NewProgressSpinner("Looking for images in component %q across %d resources", component.Name, len(resources))
 		defer spinner.Stop()
 
@@ -270,11 +299,17 @@ func (p *Packager) FindImages() (imgMap map[string][]string, err error) {
 		return nil, err
 	}
 
-	if len(erroredCharts) > 0 || len(erroredCosignLookups) > 0 {
+	if len(erroredCharts) > 0 || len(erroredCosignLookups) > 0 || len(erroredFluxResources) > 0 {
 		errMsg := ""
 		if len(erroredCharts) > 0 {
 			errMsg = fmt.Sprintf("the following charts had errors: %s", erroredCharts)
 		}
+		if len(erroredFluxResources) > 0 {
+			if errMsg != "" {
+				errMsg += "\n"
+			}
+			errMsg += fmt.Sprintf("the following Flux resources had errors: %s", erroredFluxResources)
+		}
 		if len(erroredCosignLookups) > 0 {
 			if errMsg != "" {
 				errMsg += "\n"
@@ -287,6 +322,277 @@ func (p *Packager) FindImages() (imgMap map[string][]string, err error) {
 	return imagesMap, nil
 }
 
+// findFluxResources follows the Flux HelmReleases and Kustomizations in the given resources to the charts and kustomizations
+// of their GitRepository and HelmRepository sources and returns the resources they render along with any annotated chart images.
+// Rendered resources are searched as well so that Flux objects deployed by other Flux objects are also followed.
+func findFluxResources(resources []*unstructured.Unstructured, fluxDir string, kubeVersionOverride string) (rendered []*unstructured.Unstructured, annotatedImages []string, errored []string, err error) {
+	followed := map[string]bool{}
+
+	for {
+		found, err := flux.FindResources(append(slices.Clone(resources), rendered...))
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("unable to read the Flux resources: %w", err)
+		}
+
+		var pending []*unstructured.Unstructured
+
+		for namespacedName, hr := range found.HelmReleases {
+			id := fmt.Sprintf("%s/%s", fluxHelmCtrl.HelmReleaseKind, namespacedName)
+			if followed[id] {
+				continue
+			}
+			followed[id] = true
+
+			if hr.SourceKind != fluxSrcCtrl.GitRepositoryKind && hr.SourceKind != fluxSrcCtrl.HelmRepositoryKind {
+				message.Warnf("Unable to look for images in %s, %s sources are not supported", id, hr.SourceKind)
+				continue
+			}
+
+			yamls, images, err := renderFluxHelmRelease(found, hr, filepath.Join(fluxDir, namespacedName), kubeVersionOverride)
+			if err != nil {
+				message.WarnErrf(err, "Problem rendering the chart of %s: %s", id, err.Error())
+				errored = append(errored, id)
+				continue
+			}
+			pending = append(pending, yamls...)
+			annotatedImages = append(annotatedImages, images...)
+		}
+
+		for namespacedName, kustomization := range found.Kustomizations {
+			id := fmt.Sprintf("%s/%s", flux.KustomizationKind, namespacedName)
+			if followed[id] {
+				continue
+			}
+			followed[id] = true
+
+			if kustomization.SourceKind != fluxSrcCtrl.GitRepositoryKind {
+				message.Warnf("Unable to look for images in %s, %s sources are not supported", id, kustomization.SourceKind)
+				continue
+			}
+
+			yamls, err := renderFluxKustomization(found, kustomization, filepath.Join(fluxDir, namespacedName))
+			if err != nil {
+				message.WarnErrf(err, "Problem building the kustomization of %s: %s", id, err.Error())
+				errored = append(errored, id)
+				continue
+			}
+			pending = append(pending, yamls...)
+		}
+
+		if len(pending) == 0 {
+			return rendered, annotatedImages, errored, nil
+		}
+		rendered = append(rendered, pending...)
+	}
+}
+
+// renderFluxHelmRelease pulls the chart of a Flux HelmRelease from its source and templates it with the values Flux would compose for it.
+func renderFluxHelmRelease(found flux.Resources, hr flux.HelmReleaseDependency, dir string, kubeVersionOverride string) ([]*unstructured.Unstructured, []string, error) {
+	values, err := found.ComposeValues(hr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	chart := types.ZarfChart{
+		Name:        hr.Metadata.Name,
+		ReleaseName: hr.Metadata.Name,
+		Namespace:   hr.Metadata.Namespace,
+		Version:     hr.Version,
+	}
+	// Flux pulls the latest version of a chart by default
+	if chart.Version == "*" {
+		chart.Version = ""
+	}
+
+	switch hr.SourceKind {
+	case fluxSrcCtrl.GitRepositoryKind:
+		gitRepo, err := resolveFluxGitRepository(found, hr.NamespacedSource)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		chart.URL = gitRepo.Repo()
+		chart.GitPath = hr.Chart
+	case fluxSrcCtrl.HelmRepositoryKind:
+		repoURL, ok := found.HelmRepositories[hr.NamespacedSource]
+		if !ok {
+			return nil, nil, fmt.Errorf("could not find %s '%s'", hr.SourceKind, hr.NamespacedSource)
+		}
+
+		// OCI charts are pulled from their full reference while charts in a repo index are looked up by name
+		chart.URL = repoURL
+		chart.RepoName = hr.Chart
+		if registry.IsOCI(repoURL) {
+			chart.URL = fmt.Sprintf("%s/%s", strings.TrimSuffix(repoURL, "/"), hr.Chart)
+		}
+	}
+
+	chartsPath := filepath.Join(dir, layout.ChartsDir)
+	helmCfg := helm.New(
+		chart,
+		chartsPath,
+		filepath.Join(dir, layout.ValuesDir),
+		helm.WithKubeVersion(kubeVersionOverride),
+		helm.WithValuesOverrides(values),
+	)
+
+	// GitRepository URLs do not need to end in .git so the source kind decides how the chart is pulled
+	if hr.SourceKind == fluxSrcCtrl.GitRepositoryKind {
+		err = helmCfg.PackageChartFromGit("")
+	} else {
+		err = helmCfg.DownloadPublishedChart("")
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	template, chartValues, err := helmCfg.TemplateChart()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	yamls, _ := utils.SplitYAML([]byte(template))
+
+	images, err := helm.FindAnnotatedImagesForChart(helm.StandardName(chartsPath, chart)+".tgz", chartValues)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return yamls, images, nil
+}
+
+// renderFluxKustomization builds the path of a Flux Kustomization from the GitRepository it references.
+func renderFluxKustomization(found flux.Resources, kustomization flux.Kustomization, dir string) ([]*unstructured.Unstructured, error) {
+	gitRepo, err := resolveFluxGitRepository(found, kustomization.NamespacedSource)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := utils.CreateDirectory(dir, 0700); err != nil {
+		return nil, err
+	}
+
+	spinner := message.NewProgressSpinner("Cloning %s", gitRepo.Repo())
+	defer spinner.Stop()
+
+	gitCfg := git.NewWithSpinner(types.GitServerInfo{}, spinner)
+	if err := gitCfg.Pull(gitRepo.Repo(), dir, true); err != nil {
+		return nil, err
+	}
+	spinner.Success()
+
+	kustomizationDir := filepath.Join(gitCfg.GitPath, filepath.FromSlash(path.Clean(kustomization.Path)))
+	if err := generateKustomization(kustomizationDir); err != nil {
+		return nil, err
+	}
+
+	destination := filepath.Join(dir, "kustomization.yaml")
+	if err := kustomize.Build(kustomizationDir, destination, false); err != nil {
+		return nil, err
+	}
+
+	contents, err := os.ReadFile(destination)
+	if err != nil {
+		return nil, err
+	}
+
+	return utils.SplitYAML(contents)
+}
+
+// resolveFluxGitRepository returns the GitRepository a Flux object references, resolving a semver range against the tags of the repo.
+func resolveFluxGitRepository(found flux.Resources, namespacedSource string) (flux.GitRepository, error) {
+	gitRepo, ok := found.GitRepositories[namespacedSource]
+	if !ok {
+		return gitRepo, fmt.Errorf("could not find %s '%s'", fluxSrcCtrl.GitRepositoryKind, namespacedSource)
+	}
+
+	if gitRepo.SemVer == "" {
+		return gitRepo, nil
+	}
+
+	tags, err := git.ListRemoteTags(gitRepo.URL)
+	if err != nil {
+		return gitRepo, fmt.Errorf("unable to list the tags of %s to resolve the semver range %q: %w", gitRepo.URL, gitRepo.SemVer, err)
+	}
+	if err := gitRepo.ResolveSemVer(tags); err != nil {
+		return gitRepo, err
+	}
+
+	return gitRepo, nil
+}
+
+// generateKustomization writes a kustomization listing the manifests in a directory that does not have one, as Flux does.
+// Subdirectories with their own kustomization are listed in place of their manifests.
+func generateKustomization(dir string) error {
+	if hasKustomization(dir) {
+		return nil
+	}
+
+	resources := []string{}
+	err := filepath.WalkDir(dir, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		relPath, err := filepath.Rel(dir, filePath)
+		if err != nil {
+			return err
+		}
+
+		if entry.IsDir() {
+			switch {
+			case filePath == dir:
+				return nil
+			case strings.HasPrefix(entry.Name(), "."):
+				return filepath.SkipDir
+			case hasKustomization(filePath):
+				resources = append(resources, filepath.ToSlash(relPath))
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if ext := filepath.Ext(filePath); ext != ".yaml" && ext != ".yml" {
+			return nil
+		}
+
+		// Only list the files that are Kubernetes manifests (i.e. not Helm values)
+		contents, err := os.ReadFile(filePath)
+		if err != nil {
+			return err
+		}
+		if yamls, err := utils.SplitYAML(contents); err != nil || len(yamls) == 0 {
+			return nil
+		}
+
+		resources = append(resources, filepath.ToSlash(relPath))
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(resources) == 0 {
+		return fmt.Errorf("unable to find any manifests in %s", dir)
+	}
+
+	return utils.WriteYaml(filepath.Join(dir, "kustomization.yaml"), map[string]any{
+		"apiVersion": "kustomize.config.k8s.io/v1beta1",
+		"kind":       "Kustomization",
+		"resources":  resources,
+	}, 0600)
+}
+
+// hasKustomization returns whether a directory has a kustomization file.
+func hasKustomization(dir string) bool {
+	for _, name := range []string{"kustomization.yaml", "kustomization.yml", "Kustomization"} {
+		if !utils.InvalidPath(filepath.Join(dir, name)) {
+			return true
+		}
+	}
+	return false
+}
+
 func (p *Packager) processUnstructuredImages(resource *unstructured.Unstructured, matchedImages, maybeImages k8s.ImageMap) (k8s.ImageMap, k8s.ImageMap, error) {
 	var imageSanityCheck = regexp.MustCompile(`(?mi)"image":"([^"]+)"`)
 	var imageFuzzyCheck = regexp.MustCompile(`(?mi)["|=]([a-z0-9\-.\/:]+:[\w.\-]*[a-z\.\-][\w.\-]*)"`)
@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package packager contains functions for interacting with, managing and deploying Zarf packages.
+package packager
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/yaml"
+)
+
+// TestGenerateKustomization verifies that a Flux Kustomization path without a kustomization lists its manifests as Flux would.
+func TestGenerateKustomization(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	files := map[string]string{
+		"deployment.yaml":              "apiVersion: apps/v1\nkind: Deployment\nmetadata:\n  name: podinfo\n",
+		"services/service.yml":         "apiVersion: v1\nkind: Service\nmetadata:\n  name: podinfo\n",
+		"overlay/kustomization.yaml":   "resources:\n  - configmap.yaml\n",
+		"overlay/configmap.yaml":       "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: podinfo\n",
+		"values.yaml":                  "replicaCount: 1\n",
+		"README.md":                    "# podinfo\n",
+		".github/workflows/build.yaml": "on: push\n",
+	}
+	for name, contents := range files {
+		require.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(dir, name)), 0700))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(contents), 0600))
+	}
+
+	require.NoError(t, generateKustomization(dir))
+
+	contents, err := os.ReadFile(filepath.Join(dir, "kustomization.yaml"))
+	require.NoError(t, err)
+	var kustomization map[string]any
+	require.NoError(t, yaml.Unmarshal(contents, &kustomization))
+	require.Equal(t, []any{"deployment.yaml", "overlay", "services/service.yml"}, kustomization["resources"])
+
+	// Directories that already have a kustomization are left alone
+	require.NoError(t, generateKustomization(filepath.Join(dir, "overlay")))
+	contents, err = os.ReadFile(filepath.Join(dir, "overlay", "kustomization.yaml"))
+	require.NoError(t, err)
+	require.Equal(t, files["overlay/kustomization.yaml"], string(contents))
+
+	require.Error(t, generateKustomization(t.TempDir()))
+}
@@ -24,6 +24,9 @@ import (
 
 // Publish publishes the package to a registry
 func (p *Packager) Publish() (err error) {
+	finishEvent := message.StartOperation("publish", p.cfg.PkgOpts.PackageSource)
+	defer func() { finishEvent(err) }()
+
 	_, isOCISource := p.source.(*sources.OCISource)
 	if isOCISource && p.cfg.PublishOpts.SigningKeyPath == "" {
 		ctx := context.TODO()
@@ -51,6 +54,7 @@ func (p *Packager) Publish() (err error) {
 		if err != nil {
 			return err
 		}
+		message.SetEventPackage(pkg.Metadata.Name)
 
 		// ensure cli arch matches package arch
 		if pkg.Build.Architecture != arch {
@@ -6,10 +6,15 @@ package packager
 
 import (
 	"fmt"
+
+	"github.com/defenseunicorns/zarf/src/pkg/message"
 )
 
 // Pull pulls a Zarf package and saves it as a compressed tarball.
 func (p *Packager) Pull() (err error) {
+	finishEvent := message.StartOperation("pull", p.cfg.PkgOpts.PackageSource)
+	defer func() { finishEvent(err) }()
+
 	if p.cfg.PkgOpts.OptionalComponents != "" {
 		return fmt.Errorf("pull does not support optional components")
 	}
@@ -8,11 +8,15 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 
 	"slices"
 
 	"github.com/defenseunicorns/zarf/src/config"
+	"github.com/defenseunicorns/zarf/src/config/lang"
+	"github.com/defenseunicorns/zarf/src/internal/packager/git"
 	"github.com/defenseunicorns/zarf/src/internal/packager/helm"
+	"github.com/defenseunicorns/zarf/src/internal/packager/sbom"
 	"github.com/defenseunicorns/zarf/src/pkg/cluster"
 	"github.com/defenseunicorns/zarf/src/pkg/message"
 	"github.com/defenseunicorns/zarf/src/pkg/packager/sources"
@@ -24,6 +28,9 @@ import (
 
 // Remove removes a package that was already deployed onto a cluster, uninstalling all installed helm charts.
 func (p *Packager) Remove() (err error) {
+	finishEvent := message.StartOperation("remove", p.cfg.PkgOpts.PackageSource)
+	defer func() { finishEvent(err) }()
+
 	_, isClusterSource := p.source.(*sources.ClusterSource)
 	if isClusterSource {
 		p.cluster = p.source.(*sources.ClusterSource).Cluster
@@ -80,42 +87,137 @@ func (p *Packager) Remove() (err error) {
 		}
 	}
 
+	removedRepos := []string{}
+
 	for _, dc := range helpers.Reverse(deployedPackage.DeployedComponents) {
 		// Only remove the component if it was requested or if we are removing the whole package
 		if !slices.Contains(componentsToRemove, dc.Name) {
 			continue
 		}
 
+		// Capture the mirrored repos before the component is removed from the package secret
+		removedRepos = append(removedRepos, getComponentMirroredRepos(deployedPackage, dc)...)
+
 		if deployedPackage, err = p.removeComponent(deployedPackage, dc, spinner); err != nil {
 			return fmt.Errorf("unable to remove the component '%s': %w", dc.Name, err)
 		}
 	}
 
+	// The package secret is deleted with the last component, so the stored SBOMs are no longer needed
+	if packageRequiresCluster && len(deployedPackage.DeployedComponents) == 0 {
+		p.deleteSBOMs(deployedPackage.Data)
+	}
+
+	if p.cfg.RemoveOpts.PruneRepos && len(removedRepos) > 0 {
+		spinner.Stop()
+		return p.pruneRemovedRepos(removedRepos)
+	}
+
+	return nil
+}
+
+// deleteSBOMs deletes the SBOMs a removed package stored in the zarf registry when it was deployed.
+func (p *Packager) deleteSBOMs(pkg types.ZarfPackage) {
+	// The init package removes the registry itself and YOLO packages do not store their SBOMs
+	if pkg.Kind == types.ZarfInitConfig || pkg.Metadata.YOLO {
+		return
+	}
+
+	state, err := p.cluster.LoadZarfState()
+	if err != nil || state.RegistryInfo.Address == "" {
+		message.Debugf("Unable to find the zarf registry to delete the SBOMs of %s from", pkg.Metadata.Name)
+		return
+	}
+
+	if err := sbom.DeleteFromZarfRegistry(p.cluster, pkg, state.RegistryInfo); err != nil {
+		message.Warnf("Unable to delete the SBOMs of %s from the zarf registry: %s", pkg.Metadata.Name, err.Error())
+	}
+}
+
+// getComponentMirroredRepos returns the repos a deployed component mirrored to the git server.
+func getComponentMirroredRepos(deployedPackage *types.DeployedPackage, deployedComponent types.DeployedComponent) []string {
+	// Components deployed before mirrored repos were recorded fall back to the repos in their definition
+	if len(deployedComponent.MirroredRepos) > 0 {
+		return deployedComponent.MirroredRepos
+	}
+
+	component := helpers.Find(deployedPackage.Data.Components, func(component types.ZarfComponent) bool {
+		return component.Name == deployedComponent.Name
+	})
+
+	return git.GetMirroredRepos(component.Repos)
+}
+
+// pruneRemovedRepos deletes the repos of removed components from the Zarf git server once no deployed package references them.
+func (p *Packager) pruneRemovedRepos(removedRepos []string) error {
+	state, err := p.cluster.LoadZarfState()
+	if err != nil {
+		return fmt.Errorf("unable to load the Zarf state: %w", err)
+	}
+
+	if !state.GitServer.InternalServer {
+		message.Warn(lang.PkgRemoveWarnPruneExternalGitServer)
+		return nil
+	}
+
+	deployedPackages, errs := p.cluster.GetDeployedZarfPackages()
+	if len(errs) > 0 {
+		return lang.ErrUnableToGetPackages
+	}
+
+	unreferencedRepos := git.GetUnreferencedRepos(removedRepos, deployedPackages)
+	if len(unreferencedRepos) == 0 {
+		message.Debug("Every repo of the removed components is still referenced by a deployed package")
+		return nil
+	}
+
+	spinner := message.NewProgressSpinner("Deleting %d repos from the Zarf git server", len(unreferencedRepos))
+	defer spinner.Stop()
+
+	if err := git.New(state.GitServer).PruneRepos(unreferencedRepos, false); err != nil {
+		return err
+	}
+
+	spinner.Successf("Deleted the repos %s from the Zarf git server", strings.Join(unreferencedRepos, ", "))
+
 	return nil
 }
 
 func (p *Packager) updatePackageSecret(deployedPackage types.DeployedPackage) {
 	// Only attempt to update the package secret if we are actually connected to a cluster
 	if p.cluster != nil {
-		secretName := config.ZarfPackagePrefix + deployedPackage.Name
+		// We warn and ignore errors because we may have removed the cluster that this package was inside of
+		if err := p.savePackageSecret(deployedPackage); err != nil {
+			message.Warnf("%s (this may be normal if the cluster was removed)", err.Error())
+		}
+	}
+}
 
-		// Save the new secret with the removed components removed from the secret
-		newPackageSecret := p.cluster.GenerateSecret(cluster.ZarfNamespaceName, secretName, corev1.SecretTypeOpaque)
-		newPackageSecret.Labels[cluster.ZarfPackageInfoLabel] = deployedPackage.Name
+// savePackageSecret writes the secret tracking a deployed package to the cluster.
+func (p *Packager) savePackageSecret(deployedPackage types.DeployedPackage) error {
+	secretName := config.ZarfPackagePrefix + deployedPackage.Name
 
-		newPackageSecretData, _ := json.Marshal(deployedPackage)
-		newPackageSecret.Data["data"] = newPackageSecretData
+	// Save the new secret with the removed components removed from the secret
+	newPackageSecret := p.cluster.GenerateSecret(cluster.ZarfNamespaceName, secretName, corev1.SecretTypeOpaque)
+	newPackageSecret.Labels[cluster.ZarfPackageInfoLabel] = deployedPackage.Name
 
-		_, err := p.cluster.CreateOrUpdateSecret(newPackageSecret)
+	newPackageSecretData, err := json.Marshal(deployedPackage)
+	if err != nil {
+		return fmt.Errorf("unable to marshal the '%s' package secret: %w", secretName, err)
+	}
+	newPackageSecret.Data["data"] = newPackageSecretData
 
-		// We warn and ignore errors because we may have removed the cluster that this package was inside of
-		if err != nil {
-			message.Warnf("Unable to update the '%s' package secret: '%s' (this may be normal if the cluster was removed)", secretName, err.Error())
-		}
+	if _, err := p.cluster.CreateOrUpdateSecret(newPackageSecret); err != nil {
+		return fmt.Errorf("unable to update the '%s' package secret: %w", secretName, err)
 	}
+
+	return nil
 }
 
-func (p *Packager) removeComponent(deployedPackage *types.DeployedPackage, deployedComponent types.DeployedComponent, spinner *message.Spinner) (*types.DeployedPackage, error) {
+func (p *Packager) removeComponent(deployedPackage *types.DeployedPackage, deployedComponent types.DeployedComponent, spinner *message.Spinner) (_ *types.DeployedPackage, err error) {
+	finishEvent := message.StartComponent(deployedComponent.Name)
+	defer func() { finishEvent(err) }()
+
 	components := deployedPackage.Data.Components
 
 	c := helpers.Find(components, func(t types.ZarfComponent) bool {
@@ -174,19 +276,10 @@ func (p *Packager) removeComponent(deployedPackage *types.DeployedPackage, deplo
 	})
 
 	if len(deployedPackage.DeployedComponents) == 0 && p.cluster != nil {
-		secretName := config.ZarfPackagePrefix + deployedPackage.Name
-
 		// All the installed components were deleted, therefore this package is no longer actually deployed
-		packageSecret, err := p.cluster.GetSecret(cluster.ZarfNamespaceName, secretName)
-
 		// We warn and ignore errors because we may have removed the cluster that this package was inside of
-		if err != nil {
-			message.Warnf("Unable to delete the '%s' package secret: '%s' (this may be normal if the cluster was removed)", secretName, err.Error())
-		} else {
-			err = p.cluster.DeleteSecret(packageSecret)
-			if err != nil {
-				message.Warnf("Unable to delete the '%s' package secret: '%s' (this may be normal if the cluster was removed)", secretName, err.Error())
-			}
+		if err := p.deletePackageSecret(deployedPackage.Name); err != nil {
+			message.Warnf("%s (this may be normal if the cluster was removed)", err.Error())
 		}
 	} else {
 		p.updatePackageSecret(*deployedPackage)
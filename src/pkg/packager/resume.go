@@ -0,0 +1,140 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package packager contains functions for interacting with, managing and deploying Zarf packages.
+package packager
+
+import (
+	"fmt"
+
+	"github.com/defenseunicorns/zarf/src/config/lang"
+	"github.com/defenseunicorns/zarf/src/internal/packager/helm"
+	"github.com/defenseunicorns/zarf/src/pkg/cluster"
+	"github.com/defenseunicorns/zarf/src/pkg/message"
+	"github.com/defenseunicorns/zarf/src/types"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// getResumedComponents returns the components an interrupted deployment of this package already deployed, keyed by name.
+// Components are only resumed up to the first one that failed, is missing or can no longer be verified in the cluster.
+func (p *Packager) getResumedComponents(componentsToDeploy []types.ZarfComponent) (map[string]types.DeployedComponent, error) {
+	if p.isInitConfig() {
+		return nil, fmt.Errorf(lang.PkgDeployErrResumeInitPackage)
+	}
+
+	if err := p.connectToCluster(cluster.DefaultTimeout); err != nil {
+		return nil, fmt.Errorf("unable to connect to the Kubernetes cluster: %w", err)
+	}
+
+	// Capture the package secret before skipped components are recorded so an atomic deployment can restore it
+	if err := p.snapshotDeployedPackage(); err != nil {
+		return nil, err
+	}
+
+	deployedPackage, err := p.cluster.GetDeployedPackage(p.cfg.Pkg.Metadata.Name)
+	if kerrors.IsNotFound(err) {
+		message.Notef("Package %q has not been deployed before, deploying all components", p.cfg.Pkg.Metadata.Name)
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("unable to get the deployed package %s: %w", p.cfg.Pkg.Metadata.Name, err)
+	}
+
+	// Only a deployment of the exact same package can be resumed
+	if !isSamePackageBuild(deployedPackage.Data, p.cfg.Pkg) {
+		message.Warnf("The deployed package %q was built from a different package, deploying all components", p.cfg.Pkg.Metadata.Name)
+		return nil, nil
+	}
+
+	// Continue the interrupted deployment rather than starting a new generation
+	p.generation = deployedPackage.Generation
+	for name, connectString := range deployedPackage.ConnectStrings {
+		p.connectStrings[name] = connectString
+	}
+
+	previousComponents := make(map[string]types.DeployedComponent)
+	for _, deployedComponent := range deployedPackage.DeployedComponents {
+		previousComponents[deployedComponent.Name] = deployedComponent
+	}
+
+	resumedComponents := make(map[string]types.DeployedComponent)
+	for _, component := range componentsToDeploy {
+		previous, ok := previousComponents[component.Name]
+		if !ok || previous.Status != types.ComponentStatusSucceeded || previous.ObservedGeneration != deployedPackage.Generation {
+			message.Notef("Resuming the deployment of %q from component %q", p.cfg.Pkg.Metadata.Name, component.Name)
+			return resumedComponents, nil
+		}
+
+		verified, err := p.verifyResumedComponent(component, previous)
+		if err != nil {
+			return nil, fmt.Errorf("unable to verify component %q: %w", component.Name, err)
+		}
+		if !verified {
+			message.Notef("Resuming the deployment of %q from component %q as it no longer matches the cluster", p.cfg.Pkg.Metadata.Name, component.Name)
+			return resumedComponents, nil
+		}
+
+		resumedComponents[component.Name] = previous
+	}
+
+	message.Notef("Every component of %q was already deployed, there is nothing to resume", p.cfg.Pkg.Metadata.Name)
+
+	return resumedComponents, nil
+}
+
+// verifyResumedComponent checks that the charts and images of a previously deployed component are still in the cluster.
+func (p *Packager) verifyResumedComponent(component types.ZarfComponent, deployedComponent types.DeployedComponent) (bool, error) {
+	spinner := message.NewProgressSpinner("Verifying component %q", component.Name)
+	defer spinner.Stop()
+
+	// Every chart and manifest is installed as its own Helm release
+	if len(deployedComponent.InstalledCharts) != len(component.Charts)+len(component.Manifests) {
+		return false, nil
+	}
+
+	helmCfg := helm.NewClusterOnly(p.cfg, p.cluster)
+	for _, chart := range deployedComponent.InstalledCharts {
+		spinner.Updatef("Verifying helm chart %s/%s", chart.Namespace, chart.ChartName)
+		deployed, err := helmCfg.IsReleaseDeployed(chart.Namespace, chart.ChartName)
+		if err != nil {
+			return false, err
+		}
+		if !deployed {
+			message.Debugf("Helm release %s/%s is not deployed", chart.Namespace, chart.ChartName)
+			return false, nil
+		}
+	}
+
+	if len(component.Images) > 0 {
+		spinner.Updatef("Verifying %d images", len(component.Images))
+
+		// Load the state to find the registry the images were pushed to
+		if err := p.prepareClusterValuesTemplate(false); err != nil {
+			return false, err
+		}
+
+		imgConfig, err := p.newImageConfig(component.Images, false)
+		if err != nil {
+			return false, err
+		}
+
+		missing, err := imgConfig.MissingFromZarfRegistry()
+		if err != nil {
+			return false, err
+		}
+		if len(missing) > 0 {
+			return false, nil
+		}
+	}
+
+	spinner.Successf("Component %q is already deployed", component.Name)
+
+	return true, nil
+}
+
+// isSamePackageBuild returns whether two packages came from the same package build.
+func isSamePackageBuild(a, b types.ZarfPackage) bool {
+	return a.Metadata.Name == b.Metadata.Name &&
+		a.Metadata.Version == b.Metadata.Version &&
+		a.Build.Timestamp == b.Build.Timestamp &&
+		a.Build.Flavor == b.Build.Flavor
+}
@@ -0,0 +1,169 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package packager contains functions for interacting with, managing and deploying Zarf packages.
+package packager
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/defenseunicorns/zarf/src/config"
+	"github.com/defenseunicorns/zarf/src/internal/packager/helm"
+	"github.com/defenseunicorns/zarf/src/pkg/cluster"
+	"github.com/defenseunicorns/zarf/src/pkg/message"
+	"github.com/defenseunicorns/zarf/src/pkg/utils/helpers"
+	"github.com/defenseunicorns/zarf/src/types"
+	"helm.sh/helm/v3/pkg/storage/driver"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// deployRollback tracks the cluster state an atomic deployment needs to restore if it fails.
+type deployRollback struct {
+	snapshotTaken   bool
+	previousPackage *types.DeployedPackage
+	releases        []rollbackRelease
+}
+
+// rollbackRelease is a Helm release touched during an atomic deployment.
+type rollbackRelease struct {
+	namespace   string
+	releaseName string
+	// existed is whether the release was in the cluster before this deployment
+	existed bool
+	// revision is the last deployed revision before this deployment (0 if there was none)
+	revision int
+}
+
+// snapshotDeployedPackage records the package secret as it was before this deployment touched it.
+func (p *Packager) snapshotDeployedPackage() error {
+	if !p.cfg.DeployOpts.Atomic || !p.isConnectedToCluster() {
+		return nil
+	}
+
+	if p.rollback == nil {
+		p.rollback = &deployRollback{}
+	}
+
+	if p.rollback.snapshotTaken {
+		return nil
+	}
+
+	previousPackage, err := p.cluster.GetDeployedPackage(p.cfg.Pkg.Metadata.Name)
+	if err != nil && !kerrors.IsNotFound(err) {
+		return fmt.Errorf("unable to snapshot the package secret for an atomic deployment: %w", err)
+	}
+
+	// A missing secret means this package has not been deployed before, so there is nothing to restore
+	if err == nil {
+		p.rollback.previousPackage = previousPackage
+	}
+	p.rollback.snapshotTaken = true
+
+	return nil
+}
+
+// trackReleaseForRollback records the current revision of a Helm release before it is installed or upgraded.
+func (p *Packager) trackReleaseForRollback(helmCfg *helm.Helm, namespace string) error {
+	if !p.cfg.DeployOpts.Atomic {
+		return nil
+	}
+
+	if p.rollback == nil {
+		p.rollback = &deployRollback{}
+	}
+
+	releaseName := helmCfg.ReleaseName()
+
+	// Only the first revision seen matters if a release is touched more than once
+	for _, release := range p.rollback.releases {
+		if release.namespace == namespace && release.releaseName == releaseName {
+			return nil
+		}
+	}
+
+	revision, existed, err := helmCfg.GetLastDeployedRevision()
+	if err != nil {
+		return fmt.Errorf("unable to get the release history for %s/%s: %w", namespace, releaseName, err)
+	}
+
+	p.rollback.releases = append(p.rollback.releases, rollbackRelease{
+		namespace:   namespace,
+		releaseName: releaseName,
+		existed:     existed,
+		revision:    revision,
+	})
+
+	return nil
+}
+
+// rollbackDeployment returns every Helm release touched by this deployment and the package secret to their prior state.
+func (p *Packager) rollbackDeployment(mods ...helm.Modifier) error {
+	if p.rollback == nil || !p.isConnectedToCluster() {
+		return nil
+	}
+
+	message.HeaderInfof("⏪ ROLLING BACK %s", p.cfg.Pkg.Metadata.Name)
+
+	spinner := message.NewProgressSpinner("Rolling back the deployment of %s", p.cfg.Pkg.Metadata.Name)
+	defer spinner.Stop()
+
+	var errs []error
+
+	mods = append([]helm.Modifier{helm.WithTimeout(p.cfg.DeployOpts.Timeout)}, mods...)
+	helmCfg := helm.NewClusterOnly(p.cfg, p.cluster, mods...)
+
+	// Roll back releases in the reverse order they were deployed in
+	for _, release := range helpers.Reverse(p.rollback.releases) {
+		switch {
+		case !release.existed:
+			spinner.Updatef("Uninstalling helm chart %s/%s", release.namespace, release.releaseName)
+			if err := helmCfg.RemoveChart(release.namespace, release.releaseName, spinner); err != nil && !errors.Is(err, driver.ErrReleaseNotFound) {
+				errs = append(errs, fmt.Errorf("unable to uninstall the helm chart %s/%s: %w", release.namespace, release.releaseName, err))
+			}
+		case release.revision > 0:
+			spinner.Updatef("Rolling back helm chart %s/%s to revision %d", release.namespace, release.releaseName, release.revision)
+			if err := helmCfg.RollbackChart(release.namespace, release.releaseName, release.revision, spinner); err != nil {
+				errs = append(errs, fmt.Errorf("unable to roll back the helm chart %s/%s: %w", release.namespace, release.releaseName, err))
+			}
+		default:
+			// This release was in the cluster before but never deployed successfully, so there is no good revision to return to
+			message.Warnf("Helm release %s/%s had no successfully deployed revision before this deployment and was left as is", release.namespace, release.releaseName)
+		}
+	}
+
+	if p.rollback.snapshotTaken {
+		spinner.Updatef("Restoring the package secret for %s", p.cfg.Pkg.Metadata.Name)
+		if p.rollback.previousPackage != nil {
+			if err := p.savePackageSecret(*p.rollback.previousPackage); err != nil {
+				errs = append(errs, err)
+			}
+		} else if err := p.deletePackageSecret(p.cfg.Pkg.Metadata.Name); err != nil && !kerrors.IsNotFound(err) {
+			errs = append(errs, err)
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	spinner.Success()
+
+	return nil
+}
+
+// deletePackageSecret removes the secret tracking a deployed package from the cluster.
+func (p *Packager) deletePackageSecret(packageName string) error {
+	secretName := config.ZarfPackagePrefix + packageName
+
+	packageSecret, err := p.cluster.GetSecret(cluster.ZarfNamespaceName, secretName)
+	if err != nil {
+		return fmt.Errorf("unable to get the '%s' package secret: %w", secretName, err)
+	}
+
+	if err := p.cluster.DeleteSecret(packageSecret); err != nil {
+		return fmt.Errorf("unable to delete the '%s' package secret: %w", secretName, err)
+	}
+
+	return nil
+}
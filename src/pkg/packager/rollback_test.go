@@ -0,0 +1,207 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package packager contains functions for interacting with, managing and deploying Zarf packages.
+package packager
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/defenseunicorns/zarf/src/config"
+	"github.com/defenseunicorns/zarf/src/internal/packager/helm"
+	"github.com/defenseunicorns/zarf/src/pkg/cluster"
+	"github.com/defenseunicorns/zarf/src/pkg/k8s"
+	"github.com/defenseunicorns/zarf/src/types"
+	"github.com/stretchr/testify/require"
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/chartutil"
+	kubefake "helm.sh/helm/v3/pkg/kube/fake"
+	"helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/storage"
+	"helm.sh/helm/v3/pkg/storage/driver"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8sTesting "k8s.io/client-go/testing"
+)
+
+const rollbackTestNamespace = "rollback-test"
+
+func newRollbackTestActionConfig(t *testing.T, releases ...*release.Release) *action.Configuration {
+	t.Helper()
+
+	store := storage.Init(driver.NewMemory())
+	for _, rel := range releases {
+		require.NoError(t, store.Create(rel))
+	}
+
+	return &action.Configuration{
+		Releases:     store,
+		KubeClient:   &kubefake.PrintingKubeClient{Out: io.Discard},
+		Capabilities: chartutil.DefaultCapabilities,
+		Log:          func(string, ...interface{}) {},
+	}
+}
+
+func newRollbackTestRelease(name string, version int, status release.Status) *release.Release {
+	return &release.Release{
+		Name:      name,
+		Namespace: rollbackTestNamespace,
+		Version:   version,
+		Info:      &release.Info{Status: status},
+	}
+}
+
+func newRollbackTestPackager(clientset *fake.Clientset) *Packager {
+	return &Packager{
+		cluster: &cluster.Cluster{
+			K8s: &k8s.K8s{
+				Clientset: clientset,
+				Log:       func(string, ...interface{}) {},
+			},
+		},
+		cfg: &types.PackagerConfig{
+			Pkg: types.ZarfPackage{
+				Metadata: types.ZarfMetadata{Name: "rollback-test"},
+			},
+			DeployOpts: types.ZarfDeployOptions{Atomic: true},
+		},
+	}
+}
+
+// TestTrackReleaseForRollback verifies that Zarf records each release once, in deployment order, with its prior state.
+func TestTrackReleaseForRollback(t *testing.T) {
+	t.Parallel()
+
+	actionConfig := newRollbackTestActionConfig(t,
+		newRollbackTestRelease("upgraded", 1, release.StatusSuperseded),
+		newRollbackTestRelease("upgraded", 2, release.StatusDeployed),
+		newRollbackTestRelease("upgraded", 3, release.StatusFailed),
+		newRollbackTestRelease("failed", 1, release.StatusFailed),
+	)
+
+	p := newRollbackTestPackager(fake.NewSimpleClientset())
+
+	track := func(name string) {
+		helmCfg := helm.New(types.ZarfChart{Name: name, Namespace: rollbackTestNamespace}, "", "", helm.WithActionConfig(actionConfig))
+		require.NoError(t, p.trackReleaseForRollback(helmCfg, rollbackTestNamespace))
+	}
+
+	track("new")
+	track("upgraded")
+	track("failed")
+
+	// A later revision of an already tracked release must not replace its original state
+	require.NoError(t, actionConfig.Releases.Create(newRollbackTestRelease("upgraded", 4, release.StatusDeployed)))
+	track("upgraded")
+
+	expected := []rollbackRelease{
+		{namespace: rollbackTestNamespace, releaseName: "new", existed: false, revision: 0},
+		{namespace: rollbackTestNamespace, releaseName: "upgraded", existed: true, revision: 2},
+		{namespace: rollbackTestNamespace, releaseName: "failed", existed: true, revision: 0},
+	}
+	require.Equal(t, expected, p.rollback.releases)
+}
+
+// TestTrackReleaseForRollbackNotAtomic verifies that Zarf does not track releases unless the deployment is atomic.
+func TestTrackReleaseForRollbackNotAtomic(t *testing.T) {
+	t.Parallel()
+
+	p := newRollbackTestPackager(fake.NewSimpleClientset())
+	p.cfg.DeployOpts.Atomic = false
+
+	helmCfg := helm.New(types.ZarfChart{Name: "new", Namespace: rollbackTestNamespace}, "", "", helm.WithActionConfig(newRollbackTestActionConfig(t)))
+	require.NoError(t, p.trackReleaseForRollback(helmCfg, rollbackTestNamespace))
+	require.Nil(t, p.rollback)
+}
+
+// TestRollbackDeploymentPackageSecret verifies that Zarf restores or removes the package secret based on the snapshot taken before deploying.
+func TestRollbackDeploymentPackageSecret(t *testing.T) {
+	t.Parallel()
+
+	secretName := config.ZarfPackagePrefix + "rollback-test"
+
+	type testCase struct {
+		name            string
+		previousPackage *types.DeployedPackage
+	}
+
+	testCases := []testCase{
+		{
+			name: "restores the previous package secret",
+			previousPackage: &types.DeployedPackage{
+				Name:       "rollback-test",
+				Generation: 1,
+				DeployedComponents: []types.DeployedComponent{
+					{Name: "first", Status: types.ComponentStatusSucceeded, ObservedGeneration: 1},
+				},
+			},
+		},
+		{
+			name:            "removes the package secret of a first deployment",
+			previousPackage: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			p := newRollbackTestPackager(fake.NewSimpleClientset())
+
+			if testCase.previousPackage != nil {
+				require.NoError(t, p.savePackageSecret(*testCase.previousPackage))
+			}
+
+			require.NoError(t, p.snapshotDeployedPackage())
+			require.True(t, p.rollback.snapshotTaken)
+			require.Equal(t, testCase.previousPackage, p.rollback.previousPackage)
+
+			// Simulate the failed deployment recording a new generation
+			require.NoError(t, p.savePackageSecret(types.DeployedPackage{
+				Name:       "rollback-test",
+				Generation: 2,
+				DeployedComponents: []types.DeployedComponent{
+					{Name: "first", Status: types.ComponentStatusFailed, ObservedGeneration: 2},
+				},
+			}))
+
+			require.NoError(t, p.rollbackDeployment(helm.WithActionConfig(newRollbackTestActionConfig(t))))
+
+			secret, err := p.cluster.GetSecret(cluster.ZarfNamespaceName, secretName)
+			if testCase.previousPackage == nil {
+				require.True(t, kerrors.IsNotFound(err))
+				return
+			}
+
+			require.NoError(t, err)
+			var restoredPackage types.DeployedPackage
+			require.NoError(t, json.Unmarshal(secret.Data["data"], &restoredPackage))
+			require.Equal(t, *testCase.previousPackage, restoredPackage)
+		})
+	}
+}
+
+// TestSnapshotDeployedPackageError verifies that Zarf refuses an atomic deployment it could not snapshot.
+func TestSnapshotDeployedPackageError(t *testing.T) {
+	t.Parallel()
+
+	clientset := fake.NewSimpleClientset()
+	clientset.Fake.PrependReactor("get", "secrets", func(_ k8sTesting.Action) (bool, runtime.Object, error) {
+		return true, nil, kerrors.NewServiceUnavailable("unavailable")
+	})
+
+	p := newRollbackTestPackager(clientset)
+
+	err := p.snapshotDeployedPackage()
+	require.Error(t, err)
+	require.False(t, p.rollback.snapshotTaken)
+
+	var statusErr *kerrors.StatusError
+	require.True(t, errors.As(err, &statusErr))
+}
@@ -0,0 +1,136 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package packager contains functions for interacting with, managing and deploying Zarf packages.
+package packager
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/defenseunicorns/zarf/src/pkg/message"
+	"github.com/defenseunicorns/zarf/src/pkg/utils/helpers"
+	"github.com/defenseunicorns/zarf/src/types"
+)
+
+// componentResult is the outcome of running a single component.
+type componentResult struct {
+	idx int
+	err error
+}
+
+// runInDependencyOrder runs each component once every component it depends on has succeeded, running up to concurrency components at a time.
+// Components are started in package order whenever more than one is ready, so a concurrency of 1 keeps the existing sequential behavior.
+// After a component fails no new components are started and the errors of every failed component are returned once the running ones finish.
+func runInDependencyOrder(components []types.ZarfComponent, concurrency int, run func(idx int, component types.ZarfComponent) error) error {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	indexes := make(map[string]int, len(components))
+	for idx, component := range components {
+		indexes[component.Name] = idx
+	}
+
+	unmetDependencies := make([]int, len(components))
+	dependents := make([][]int, len(components))
+	addDependency := func(idx, dependencyIdx int) {
+		unmetDependencies[idx]++
+		dependents[dependencyIdx] = append(dependents[dependencyIdx], idx)
+	}
+
+	for idx, component := range components {
+		for _, dependency := range helpers.Unique(component.DependsOn) {
+			dependencyIdx, ok := indexes[dependency]
+			if !ok {
+				// The dependency was not selected for this deployment, so there is nothing to wait on
+				message.Debugf("Component %q depends on %q which is not being deployed", component.Name, dependency)
+				continue
+			}
+			if dependencyIdx != idx {
+				addDependency(idx, dependencyIdx)
+			}
+		}
+
+		// Components that set variables run on their own so later components see the same variables they would sequentially
+		if setsVariables(component) {
+			for otherIdx := range components {
+				switch {
+				case otherIdx < idx:
+					addDependency(idx, otherIdx)
+				case otherIdx > idx:
+					addDependency(otherIdx, idx)
+				}
+			}
+		}
+	}
+
+	results := make(chan componentResult)
+	started := make([]bool, len(components))
+	failures := make([]error, len(components))
+	running := 0
+	failed := false
+
+	for {
+		// Start the ready components in package order until the concurrency limit is reached
+		for idx := 0; idx < len(components) && running < concurrency && !failed; idx++ {
+			if started[idx] || unmetDependencies[idx] > 0 {
+				continue
+			}
+
+			started[idx] = true
+			running++
+			go func(idx int) {
+				results <- componentResult{idx: idx, err: run(idx, components[idx])}
+			}(idx)
+		}
+
+		if running == 0 {
+			break
+		}
+
+		result := <-results
+		running--
+
+		if result.err != nil {
+			failures[result.idx] = result.err
+			failed = true
+			continue
+		}
+
+		for _, dependentIdx := range dependents[result.idx] {
+			unmetDependencies[dependentIdx]--
+		}
+	}
+
+	if failed {
+		return errors.Join(failures...)
+	}
+
+	// Anything left unstarted without a failure is waiting on a circular dependency
+	blocked := []string{}
+	for idx, component := range components {
+		if !started[idx] {
+			blocked = append(blocked, component.Name)
+		}
+	}
+	if len(blocked) > 0 {
+		return fmt.Errorf("unable to deploy components with circular dependencies: %s", strings.Join(blocked, ", "))
+	}
+
+	return nil
+}
+
+// setsVariables returns whether any of the onDeploy actions of a component set variables.
+func setsVariables(component types.ZarfComponent) bool {
+	onDeploy := component.Actions.OnDeploy
+	for _, actions := range [][]types.ZarfComponentAction{onDeploy.Before, onDeploy.After, onDeploy.OnSuccess, onDeploy.OnFailure} {
+		for _, action := range actions {
+			if len(action.SetVariables) > 0 || action.DeprecatedSetVariable != "" {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,155 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package packager contains functions for interacting with, managing and deploying Zarf packages.
+package packager
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/defenseunicorns/zarf/src/types"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRunInDependencyOrder verifies that Zarf only starts components once the components they depend on have succeeded.
+func TestRunInDependencyOrder(t *testing.T) {
+	t.Parallel()
+
+	setVariable := types.ZarfComponentActions{
+		OnDeploy: types.ZarfComponentActionSet{
+			After: []types.ZarfComponentAction{
+				{SetVariables: []types.ZarfComponentActionSetVariable{{Name: "TEST"}}},
+			},
+		},
+	}
+
+	type testCase struct {
+		name          string
+		components    []types.ZarfComponent
+		concurrency   int
+		failing       string
+		expectedOrder []string
+		expectedErr   bool
+	}
+
+	testCases := []testCase{
+		{
+			name: "sequential without dependencies",
+			components: []types.ZarfComponent{
+				{Name: "a"}, {Name: "b"}, {Name: "c"},
+			},
+			concurrency:   1,
+			expectedOrder: []string{"a", "b", "c"},
+		},
+		{
+			name: "dependencies reorder a sequential deployment",
+			components: []types.ZarfComponent{
+				{Name: "a", DependsOn: []string{"c"}}, {Name: "b"}, {Name: "c"},
+			},
+			concurrency:   1,
+			expectedOrder: []string{"b", "c", "a"},
+		},
+		{
+			name: "dependencies that are not deployed are ignored",
+			components: []types.ZarfComponent{
+				{Name: "a", DependsOn: []string{"missing"}}, {Name: "b", DependsOn: []string{"a"}},
+			},
+			concurrency:   3,
+			expectedOrder: []string{"a", "b"},
+		},
+		{
+			name: "dependents of a failed component are not started",
+			components: []types.ZarfComponent{
+				{Name: "a"}, {Name: "b", DependsOn: []string{"a"}}, {Name: "c", DependsOn: []string{"b"}},
+			},
+			concurrency:   3,
+			failing:       "a",
+			expectedOrder: []string{"a"},
+			expectedErr:   true,
+		},
+		{
+			name: "components that set variables run on their own",
+			components: []types.ZarfComponent{
+				{Name: "a"}, {Name: "b", Actions: setVariable}, {Name: "c"},
+			},
+			concurrency:   3,
+			expectedOrder: []string{"a", "b", "c"},
+		},
+		{
+			name: "circular dependencies are never started",
+			components: []types.ZarfComponent{
+				{Name: "a", DependsOn: []string{"b"}}, {Name: "b", DependsOn: []string{"a"}},
+			},
+			concurrency:   2,
+			expectedOrder: []string{},
+			expectedErr:   true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var mutex sync.Mutex
+			order := []string{}
+
+			err := runInDependencyOrder(testCase.components, testCase.concurrency, func(_ int, component types.ZarfComponent) error {
+				mutex.Lock()
+				defer mutex.Unlock()
+
+				order = append(order, component.Name)
+				if component.Name == testCase.failing {
+					return errors.New("failed")
+				}
+				return nil
+			})
+
+			if testCase.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, testCase.expectedOrder, order)
+		})
+	}
+}
+
+// TestRunInDependencyOrderConcurrency verifies that Zarf never runs more components at once than the concurrency allows.
+func TestRunInDependencyOrderConcurrency(t *testing.T) {
+	t.Parallel()
+
+	components := []types.ZarfComponent{
+		{Name: "a"}, {Name: "b"}, {Name: "c"}, {Name: "d"}, {Name: "e"},
+	}
+
+	var mutex sync.Mutex
+	running, maxRunning := 0, 0
+	release := make(chan struct{})
+
+	go func() {
+		for range components {
+			release <- struct{}{}
+		}
+	}()
+
+	err := runInDependencyOrder(components, 2, func(_ int, _ types.ZarfComponent) error {
+		mutex.Lock()
+		running++
+		maxRunning = max(maxRunning, running)
+		mutex.Unlock()
+
+		<-release
+
+		mutex.Lock()
+		running--
+		mutex.Unlock()
+		return nil
+	})
+
+	require.NoError(t, err)
+	require.LessOrEqual(t, maxRunning, 2)
+}
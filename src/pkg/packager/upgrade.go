@@ -0,0 +1,182 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package packager contains functions for interacting with, managing and deploying Zarf packages.
+package packager
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/defenseunicorns/zarf/src/config"
+	"github.com/defenseunicorns/zarf/src/config/lang"
+	"github.com/defenseunicorns/zarf/src/pkg/cluster"
+	"github.com/defenseunicorns/zarf/src/pkg/message"
+	"github.com/defenseunicorns/zarf/src/pkg/utils/helpers"
+	"github.com/defenseunicorns/zarf/src/types"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// Upgrade deploys a new version of an already deployed package and removes the components the new version no longer has.
+func (p *Packager) Upgrade() (err error) {
+	finishEvent := message.StartOperation("upgrade", p.cfg.PkgOpts.PackageSource)
+	defer func() { finishEvent(err) }()
+
+	if err = p.loadPackageForDeploy(); err != nil {
+		return err
+	}
+
+	if p.isInitConfig() {
+		return fmt.Errorf(lang.PkgUpgradeErrInitPackage)
+	}
+
+	if err := p.connectToCluster(cluster.DefaultTimeout); err != nil {
+		return fmt.Errorf("unable to connect to the Kubernetes cluster: %w", err)
+	}
+
+	deployedPackage, err := p.cluster.GetDeployedPackage(p.cfg.Pkg.Metadata.Name)
+	if kerrors.IsNotFound(err) {
+		return fmt.Errorf(lang.PkgUpgradeErrNotDeployed, p.cfg.Pkg.Metadata.Name)
+	} else if err != nil {
+		return fmt.Errorf("unable to get the deployed package %s: %w", p.cfg.Pkg.Metadata.Name, err)
+	}
+
+	p.orphanedComponents = getOrphanedComponents(deployedPackage, p.cfg.Pkg.Components)
+
+	// Plans only report what would change, so skip the SBOM review and confirmation
+	if p.cfg.DeployOpts.Plan {
+		p.printOrphanedComponents()
+		p.filterComponents()
+		return p.plan()
+	}
+
+	if err := p.stageSBOMViewFiles(); err != nil {
+		return err
+	}
+
+	// Confirm the upgrade along with the components it will remove
+	if !p.confirmAction(config.ZarfUpgradeStage) {
+		return fmt.Errorf("upgrade cancelled")
+	}
+
+	// The package secret keeps track of the orphaned components while the new version deploys, so they are only removed
+	// once it succeeds and a failed (or rolled back) upgrade leaves them in place
+	if err := p.deployPackage(); err != nil {
+		return err
+	}
+
+	deployedPackage, err = p.cluster.GetDeployedPackage(p.cfg.Pkg.Metadata.Name)
+	if err != nil {
+		return fmt.Errorf("unable to get the deployed package %s: %w", p.cfg.Pkg.Metadata.Name, err)
+	}
+
+	return p.removeOrphanedComponents(deployedPackage)
+}
+
+// orphanedComponent is a deployed component that the new version of a package no longer has.
+type orphanedComponent struct {
+	deployed types.DeployedComponent
+	// definition is the component from the version of the package that deployed it
+	definition types.ZarfComponent
+}
+
+// getOrphanedComponents returns the deployed components that are no longer part of the new version of a package.
+func getOrphanedComponents(deployedPackage *types.DeployedPackage, components []types.ZarfComponent) []orphanedComponent {
+	orphanedComponents := []orphanedComponent{}
+
+	for _, deployedComponent := range deployedPackage.DeployedComponents {
+		if slices.ContainsFunc(components, func(component types.ZarfComponent) bool {
+			return component.Name == deployedComponent.Name
+		}) {
+			continue
+		}
+
+		orphanedComponents = append(orphanedComponents, orphanedComponent{
+			deployed: deployedComponent,
+			definition: helpers.Find(deployedPackage.Data.Components, func(component types.ZarfComponent) bool {
+				return component.Name == deployedComponent.Name
+			}),
+		})
+	}
+
+	return orphanedComponents
+}
+
+// withOrphanedComponents adds the orphaned components of an upgrade to the package and its deployed components so that
+// the package secret keeps track of them until they are removed.
+func (p *Packager) withOrphanedComponents(deployedComponents []types.DeployedComponent) (types.ZarfPackage, []types.DeployedComponent) {
+	pkg := p.cfg.Pkg
+	if len(p.orphanedComponents) == 0 {
+		return pkg, deployedComponents
+	}
+
+	pkg.Components = slices.Clone(pkg.Components)
+	for _, orphan := range p.orphanedComponents {
+		pkg.Components = append(pkg.Components, orphan.definition)
+		deployedComponents = append(deployedComponents, orphan.deployed)
+	}
+
+	return pkg, deployedComponents
+}
+
+// printOrphanedComponents prints the components an upgrade will remove and what removing them does.
+func (p *Packager) printOrphanedComponents() {
+	if len(p.orphanedComponents) == 0 {
+		return
+	}
+
+	message.HorizontalRule()
+	message.Title("Orphaned Components", "components of the deployed package that this version no longer has and will be removed")
+
+	orphanData := [][]string{}
+	for _, orphan := range p.orphanedComponents {
+		releases := []string{}
+		for _, chart := range orphan.deployed.InstalledCharts {
+			releases = append(releases, fmt.Sprintf("%s/%s", chart.Namespace, chart.ChartName))
+		}
+
+		onRemove := orphan.definition.Actions.OnRemove
+		actionCount := len(onRemove.Before) + len(onRemove.After) + len(onRemove.OnSuccess)
+
+		orphanData = append(orphanData, []string{orphan.deployed.Name, strings.Join(releases, ", "), strconv.Itoa(actionCount)})
+	}
+
+	message.Table([]string{"Component", "Helm Releases", "onRemove Actions"}, orphanData)
+}
+
+// removeOrphanedComponents removes the orphaned components of an upgrade, running their onRemove actions.
+func (p *Packager) removeOrphanedComponents(deployedPackage *types.DeployedPackage) (err error) {
+	if len(p.orphanedComponents) == 0 {
+		return nil
+	}
+
+	// Keep the package (and its stored variables) if the upgrade did not deploy any of its new components
+	if len(deployedPackage.DeployedComponents) == len(p.orphanedComponents) {
+		message.Warnf("Not removing the orphaned components from %s as no other components were deployed", p.cfg.Pkg.Metadata.Name)
+		return nil
+	}
+
+	spinner := message.NewProgressSpinner("Removing orphaned components from %s", p.cfg.Pkg.Metadata.Name)
+	defer spinner.Stop()
+
+	// Remove components in the reverse order they were deployed in
+	for _, orphan := range helpers.Reverse(p.orphanedComponents) {
+		if deployedPackage, err = p.removeComponent(deployedPackage, orphan.deployed, spinner); err != nil {
+			return fmt.Errorf("unable to remove the orphaned component '%s': %w", orphan.deployed.Name, err)
+		}
+	}
+
+	// Drop the definitions of the removed components that were kept with the package while it deployed
+	deployedPackage.Data.Components = slices.DeleteFunc(deployedPackage.Data.Components, func(component types.ZarfComponent) bool {
+		return slices.ContainsFunc(p.orphanedComponents, func(orphan orphanedComponent) bool {
+			return orphan.deployed.Name == component.Name
+		})
+	})
+	p.updatePackageSecret(*deployedPackage)
+
+	spinner.Successf("Removed %d orphaned components from %s", len(p.orphanedComponents), p.cfg.Pkg.Metadata.Name)
+
+	return nil
+}
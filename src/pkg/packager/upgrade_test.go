@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package packager contains functions for interacting with, managing and deploying Zarf packages.
+package packager
+
+import (
+	"testing"
+
+	"github.com/defenseunicorns/zarf/src/types"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGetOrphanedComponents verifies that Zarf finds the deployed components a new version of a package no longer has.
+func TestGetOrphanedComponents(t *testing.T) {
+	t.Parallel()
+
+	onRemove := types.ZarfComponentActions{
+		OnRemove: types.ZarfComponentActionSet{
+			Before: []types.ZarfComponentAction{{Cmd: "echo removing"}},
+		},
+	}
+
+	deployedPackage := &types.DeployedPackage{
+		Data: types.ZarfPackage{
+			Components: []types.ZarfComponent{
+				{Name: "kept"}, {Name: "dropped", Actions: onRemove}, {Name: "not-deployed"},
+			},
+		},
+		DeployedComponents: []types.DeployedComponent{
+			{Name: "kept"}, {Name: "dropped"},
+		},
+	}
+
+	type testCase struct {
+		name       string
+		components []types.ZarfComponent
+		expected   []orphanedComponent
+	}
+
+	testCases := []testCase{
+		{
+			name:       "no components are dropped",
+			components: []types.ZarfComponent{{Name: "kept"}, {Name: "dropped"}, {Name: "added"}},
+			expected:   []orphanedComponent{},
+		},
+		{
+			name:       "dropped components keep their deployed definition",
+			components: []types.ZarfComponent{{Name: "kept"}, {Name: "added"}},
+			expected: []orphanedComponent{
+				{deployed: types.DeployedComponent{Name: "dropped"}, definition: types.ZarfComponent{Name: "dropped", Actions: onRemove}},
+			},
+		},
+		{
+			name:       "every component is dropped",
+			components: []types.ZarfComponent{},
+			expected: []orphanedComponent{
+				{deployed: types.DeployedComponent{Name: "kept"}, definition: types.ZarfComponent{Name: "kept"}},
+				{deployed: types.DeployedComponent{Name: "dropped"}, definition: types.ZarfComponent{Name: "dropped", Actions: onRemove}},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, testCase.expected, getOrphanedComponents(deployedPackage, testCase.components))
+		})
+	}
+}
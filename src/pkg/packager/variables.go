@@ -6,13 +6,25 @@ package packager
 
 import (
 	"fmt"
+	"os"
 	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"time"
 
 	"github.com/defenseunicorns/zarf/src/config"
 	"github.com/defenseunicorns/zarf/src/config/lang"
+	"github.com/defenseunicorns/zarf/src/internal/packager/helm"
+	"github.com/defenseunicorns/zarf/src/internal/packager/variables"
+	"github.com/defenseunicorns/zarf/src/pkg/cluster"
 	"github.com/defenseunicorns/zarf/src/pkg/interactive"
+	"github.com/defenseunicorns/zarf/src/pkg/message"
 	"github.com/defenseunicorns/zarf/src/pkg/utils"
 	"github.com/defenseunicorns/zarf/src/types"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/yaml"
 )
 
 // ReloadComponentTemplate appends ###ZARF_COMPONENT_NAME### for the component, assigns value, and reloads
@@ -103,6 +115,14 @@ func (p *Packager) setVariableMapInConfig() error {
 		p.setVariableInConfig(name, value, false, false, "")
 	}
 
+	// Re-apply the values previously --set for variables the package does not declare
+	for name, value := range p.previousVariables {
+		if _, present := p.cfg.SetVariableMap[name]; present || p.isDeclaredVariable(name) {
+			continue
+		}
+		p.setVariableInConfig(name, value, false, false, "")
+	}
+
 	for _, variable := range p.cfg.Pkg.Variables {
 		_, present := p.cfg.SetVariableMap[variable.Name]
 
@@ -111,17 +131,37 @@ func (p *Packager) setVariableMapInConfig() error {
 			p.cfg.SetVariableMap[variable.Name].Sensitive = variable.Sensitive
 			p.cfg.SetVariableMap[variable.Name].AutoIndent = variable.AutoIndent
 			p.cfg.SetVariableMap[variable.Name].Type = variable.Type
-			if err := p.checkVariablePattern(variable.Name, variable.Pattern); err != nil {
+			if err := p.checkVariableValue(variable); err != nil {
 				return err
 			}
 			continue
 		}
 
+		// Variables with a source are resolved from it in place of the default or a prompt
+		if variable.Source != "" {
+			val, err := p.resolveVariableSource(variable)
+			if err != nil {
+				return err
+			}
+
+			p.setVariableInConfig(variable.Name, val, variable.Sensitive, variable.AutoIndent, variable.Type)
+			if err := p.checkVariableValue(variable); err != nil {
+				return err
+			}
+			continue
+		}
+
+		// Default to the value the previous deployment used, sensitive values are not prompted for again so they are never shown
+		previous, hasPrevious := p.previousVariables[variable.Name]
+		if hasPrevious {
+			variable.Default = previous
+		}
+
 		// First set default (may be overridden by prompt)
 		p.setVariableInConfig(variable.Name, variable.Default, variable.Sensitive, variable.AutoIndent, variable.Type)
 
-		// Variable is set to prompt the user
-		if variable.Prompt && !config.CommonOptions.Confirm {
+		// Variable is set to prompt the user (plans never prompt and use the default instead)
+		if variable.Prompt && !config.CommonOptions.Confirm && !p.cfg.DeployOpts.Plan && !(hasPrevious && variable.Sensitive) {
 			// Prompt the user for the variable
 			val, err := interactive.PromptVariable(variable)
 
@@ -132,7 +172,7 @@ func (p *Packager) setVariableMapInConfig() error {
 			p.setVariableInConfig(variable.Name, val, variable.Sensitive, variable.AutoIndent, variable.Type)
 		}
 
-		if err := p.checkVariablePattern(variable.Name, variable.Pattern); err != nil {
+		if err := p.checkVariableValue(variable); err != nil {
 			return err
 		}
 	}
@@ -140,6 +180,161 @@ func (p *Packager) setVariableMapInConfig() error {
 	return nil
 }
 
+// resolveVariableSource reads the value of a variable from its source, connecting to the cluster for k8sSecret sources.
+func (p *Packager) resolveVariableSource(variable types.ZarfPackageVariable) (string, error) {
+	source, err := variables.ParseSource(variable.Source)
+	if err != nil {
+		return "", fmt.Errorf(lang.PkgDeployErrVariableSource, variable.Name, variable.Source, err)
+	}
+
+	message.Debugf("Resolving variable %s from %s", variable.Name, variable.Source)
+
+	getSecret := func(namespace, name string) (*corev1.Secret, error) {
+		if err := p.connectToCluster(cluster.DefaultTimeout); err != nil {
+			return nil, fmt.Errorf("unable to connect to the cluster: %w", err)
+		}
+		return p.cluster.GetSecret(namespace, name)
+	}
+
+	value, err := source.Resolve(getSecret)
+	if err != nil {
+		return "", fmt.Errorf(lang.PkgDeployErrVariableSource, variable.Name, variable.Source, err)
+	}
+
+	return value, nil
+}
+
+// loadPreviousVariables loads the variables the previous deployment of the package was recorded with so they become the new defaults.
+func (p *Packager) loadPreviousVariables() error {
+	p.previousVariables = map[string]string{}
+
+	// Connect early when the package needs the cluster anyway, init packages may be creating the cluster so they are left alone
+	if !p.isConnectedToCluster() && !p.isInitConfig() && slices.ContainsFunc(p.cfg.Pkg.Components, requiresCluster) {
+		if err := p.connectToCluster(5 * time.Second); err != nil {
+			// A failed cluster check halts the deployment, an unreachable cluster is retried once a component needs it
+			if p.isConnectedToCluster() {
+				return err
+			}
+			message.Debugf("Unable to connect to the cluster to load the previous variables of %s: %s", p.cfg.Pkg.Metadata.Name, err.Error())
+		}
+	}
+
+	if !p.isConnectedToCluster() {
+		return nil
+	}
+
+	deployedPackage, err := p.cluster.GetDeployedPackage(p.cfg.Pkg.Metadata.Name)
+	if err != nil {
+		if !kerrors.IsNotFound(err) {
+			message.Debugf("Unable to get the previous variables of %s: %s", p.cfg.Pkg.Metadata.Name, err.Error())
+		}
+		return nil
+	}
+
+	for name, variable := range deployedPackage.Variables {
+		value := variable.Value
+		if variable.Encrypted {
+			if p.cfg.PkgOpts.VariablesKey == "" {
+				message.Warnf(lang.PkgDeployWarnVariableNoKey, name)
+				continue
+			}
+			if value, err = variables.Decrypt(value, p.cfg.PkgOpts.VariablesKey); err != nil {
+				message.Warnf(lang.PkgDeployWarnVariableDecrypt, name, err.Error())
+				continue
+			}
+		}
+		p.previousVariables[name] = value
+	}
+
+	return nil
+}
+
+// getDeployedVariables returns the declared and --set variables to record with the deployment,
+// sensitive variables are only recorded (encrypted) when a --variables-key is given.
+func (p *Packager) getDeployedVariables() (map[string]types.DeployedVariable, error) {
+	deployedVariables := map[string]types.DeployedVariable{}
+
+	for name, variable := range p.cfg.SetVariableMap {
+		_, set := p.cfg.PkgOpts.SetVariables[name]
+		_, previous := p.previousVariables[name]
+		if !set && !previous && !p.isDeclaredVariable(name) {
+			// Variables set by actions are set again each deployment
+			continue
+		}
+		if p.isSourcedVariable(name) {
+			// Variables with a source are resolved again each deployment and are kept out of the package secret
+			continue
+		}
+
+		if !variable.Sensitive {
+			deployedVariables[name] = types.DeployedVariable{Value: variable.Value}
+			continue
+		}
+
+		if p.cfg.PkgOpts.VariablesKey == "" {
+			continue
+		}
+		encrypted, err := variables.Encrypt(variable.Value, p.cfg.PkgOpts.VariablesKey)
+		if err != nil {
+			return nil, fmt.Errorf("unable to encrypt variable %q: %w", name, err)
+		}
+		deployedVariables[name] = types.DeployedVariable{Value: encrypted, Sensitive: true, Encrypted: true}
+	}
+
+	return deployedVariables, nil
+}
+
+// printVariableChanges prints the variables whose values differ from the previous deployment of the package.
+func (p *Packager) printVariableChanges() {
+	if len(p.previousVariables) == 0 {
+		return
+	}
+
+	names := []string{}
+	for name, variable := range p.cfg.SetVariableMap {
+		if previous, ok := p.previousVariables[name]; !ok || previous != variable.Value {
+			if _, recorded := p.deployedVariables[name]; recorded || ok {
+				names = append(names, name)
+			}
+		}
+	}
+	if len(names) == 0 {
+		return
+	}
+	sort.Strings(names)
+
+	message.HorizontalRule()
+	message.Title("Changed Variables", "variables whose values differ from the previous deployment of this package")
+
+	variableData := [][]string{}
+	for _, name := range names {
+		previous, ok := p.previousVariables[name]
+		current := p.cfg.SetVariableMap[name].Value
+		if !ok {
+			previous = "(not set)"
+		}
+		if p.cfg.SetVariableMap[name].Sensitive {
+			previous, current = "**sanitized**", "**sanitized**"
+		}
+		variableData = append(variableData, []string{name, message.Truncate(previous, 40, false), message.Truncate(current, 40, false)})
+	}
+	message.Table([]string{"Variable", "Previous", "New"}, variableData)
+}
+
+// isDeclaredVariable returns whether the package declares a variable with the given name.
+func (p *Packager) isDeclaredVariable(name string) bool {
+	return slices.ContainsFunc(p.cfg.Pkg.Variables, func(variable types.ZarfPackageVariable) bool {
+		return variable.Name == name
+	})
+}
+
+// isSourcedVariable returns whether a package variable resolves its value from a source.
+func (p *Packager) isSourcedVariable(name string) bool {
+	return slices.ContainsFunc(p.cfg.Pkg.Variables, func(variable types.ZarfPackageVariable) bool {
+		return variable.Name == name && variable.Source != ""
+	})
+}
+
 func (p *Packager) setVariableInConfig(name, value string, sensitive bool, autoIndent bool, varType types.VariableType) {
 	p.cfg.SetVariableMap[name] = &types.ZarfSetVariable{
 		Name:       name,
@@ -158,3 +353,58 @@ func (p *Packager) checkVariablePattern(name, pattern string) error {
 
 	return fmt.Errorf("provided value for variable %q does not match pattern \"%s\"", name, pattern)
 }
+
+// checkVariableValue checks to see if a declared variable is set to a value that matches its pattern and type
+func (p *Packager) checkVariableValue(variable types.ZarfPackageVariable) error {
+	if err := p.checkVariablePattern(variable.Name, variable.Pattern); err != nil {
+		return err
+	}
+
+	if err := variables.Validate(variable, p.cfg.SetVariableMap[variable.Name].Value); err != nil {
+		return fmt.Errorf(lang.PkgDeployErrVariableType, variable.Name, variable.Type, err)
+	}
+
+	return nil
+}
+
+// getChartVariableValues returns the Helm values a chart sets from variables, variables that are not set leave the values of the chart alone.
+func (p *Packager) getChartVariableValues(chart types.ZarfChart) (map[string]any, error) {
+	chartValues := map[string]any{}
+
+	for _, chartVariable := range chart.Variables {
+		variable, ok := p.cfg.SetVariableMap[chartVariable.Name]
+		if !ok || variable.Value == "" {
+			continue
+		}
+
+		value, err := typedVariableValue(variable)
+		if err != nil {
+			return nil, fmt.Errorf(lang.PkgDeployErrChartVariable, chartVariable.Path, chart.Name, chartVariable.Name, err)
+		}
+
+		if err := helm.SetValuesPath(chartValues, chartVariable.Path, value); err != nil {
+			return nil, fmt.Errorf(lang.PkgDeployErrChartVariable, chartVariable.Path, chart.Name, chartVariable.Name, err)
+		}
+	}
+
+	return chartValues, nil
+}
+
+// typedVariableValue returns the value of a variable as its type, so int, bool, yaml and json variables are not set as strings.
+func typedVariableValue(variable *types.ZarfSetVariable) (any, error) {
+	switch variable.Type {
+	case types.IntVariableType:
+		return strconv.Atoi(variable.Value)
+	case types.BoolVariableType:
+		return strconv.ParseBool(variable.Value)
+	case types.YAMLVariableType, types.JSONVariableType:
+		var value any
+		err := yaml.Unmarshal([]byte(variable.Value), &value)
+		return value, err
+	case types.FileVariableType:
+		contents, err := os.ReadFile(variable.Value)
+		return string(contents), err
+	default:
+		return variable.Value, nil
+	}
+}
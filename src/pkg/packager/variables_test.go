@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package packager contains functions for interacting with, managing and deploying Zarf packages.
+package packager
+
+import (
+	"testing"
+
+	"github.com/defenseunicorns/zarf/src/types"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGetChartVariableValues verifies that chart variables set Helm values with the types of their variables.
+func TestGetChartVariableValues(t *testing.T) {
+	t.Parallel()
+
+	p := &Packager{cfg: &types.PackagerConfig{SetVariableMap: map[string]*types.ZarfSetVariable{
+		"DOMAIN":   {Name: "DOMAIN", Value: "app.example.com", Type: types.HostnameVariableType},
+		"REPLICAS": {Name: "REPLICAS", Value: "3", Type: types.IntVariableType},
+		"TLS":      {Name: "TLS", Value: "true", Type: types.BoolVariableType},
+		"EXTRA":    {Name: "EXTRA", Value: "a: 1\nb: [x]", Type: types.YAMLVariableType},
+		"EMPTY":    {Name: "EMPTY", Value: ""},
+		"BAD_INT":  {Name: "BAD_INT", Value: "three", Type: types.IntVariableType},
+	}}}
+
+	chartValues, err := p.getChartVariableValues(types.ZarfChart{Name: "podinfo", Variables: []types.ZarfChartVariable{
+		{Name: "DOMAIN", Path: "ingress.host"},
+		{Name: "REPLICAS", Path: "replicaCount"},
+		{Name: "TLS", Path: "ingress.tls.enabled"},
+		{Name: "EXTRA", Path: `podAnnotations.example\.com/extra`},
+		{Name: "EMPTY", Path: "ingress.className"},
+		{Name: "UNSET", Path: "ingress.path"},
+	}})
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{
+		"ingress": map[string]any{
+			"host": "app.example.com",
+			"tls":  map[string]any{"enabled": true},
+		},
+		"replicaCount":   3,
+		"podAnnotations": map[string]any{"example.com/extra": map[string]any{"a": float64(1), "b": []any{"x"}}},
+	}, chartValues)
+
+	_, err = p.getChartVariableValues(types.ZarfChart{Name: "podinfo", Variables: []types.ZarfChartVariable{{Name: "BAD_INT", Path: "replicaCount"}}})
+	require.Error(t, err)
+
+	_, err = p.getChartVariableValues(types.ZarfChart{Name: "podinfo", Variables: []types.ZarfChartVariable{
+		{Name: "REPLICAS", Path: "replicaCount"},
+		{Name: "DOMAIN", Path: "replicaCount.host"},
+	}})
+	require.Error(t, err)
+}
+
+// TestGetDeployedVariables verifies that only the variables a later deployment should default to are stored with the package.
+func TestGetDeployedVariables(t *testing.T) {
+	t.Parallel()
+
+	p := &Packager{cfg: &types.PackagerConfig{
+		Pkg: types.ZarfPackage{Variables: []types.ZarfPackageVariable{
+			{Name: "DOMAIN"},
+			{Name: "PASSWORD", Source: "vault://vault.example.com/secret/app#password"},
+			{Name: "TOKEN", Sensitive: true},
+		}},
+		SetVariableMap: map[string]*types.ZarfSetVariable{
+			"DOMAIN":   {Name: "DOMAIN", Value: "app.example.com"},
+			"PASSWORD": {Name: "PASSWORD", Value: "hunter2"},
+			"TOKEN":    {Name: "TOKEN", Value: "secret-token", Sensitive: true},
+			"ACTION":   {Name: "ACTION", Value: "set-by-an-action"},
+		},
+	}}
+
+	deployedVariables, err := p.getDeployedVariables()
+	require.NoError(t, err)
+	require.Equal(t, map[string]types.DeployedVariable{"DOMAIN": {Value: "app.example.com"}}, deployedVariables)
+}
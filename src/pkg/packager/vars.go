@@ -0,0 +1,121 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package packager contains functions for interacting with, managing and deploying Zarf packages.
+package packager
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/defenseunicorns/zarf/src/config/lang"
+	"github.com/defenseunicorns/zarf/src/internal/packager/variables"
+	"github.com/defenseunicorns/zarf/src/pkg/message"
+	"github.com/defenseunicorns/zarf/src/pkg/packager/sources"
+	"github.com/defenseunicorns/zarf/src/pkg/utils/helpers"
+	"github.com/defenseunicorns/zarf/src/types"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// Vars prints the variables stored with a deployed package after applying any --set or --unset changes to them.
+func (p *Packager) Vars() (err error) {
+	clusterSource, ok := p.source.(*sources.ClusterSource)
+	if !ok {
+		return fmt.Errorf("package vars requires the name of a deployed package")
+	}
+	p.cluster = clusterSource.Cluster
+
+	packageName := p.cfg.PkgOpts.PackageSource
+	deployedPackage, err := p.cluster.GetDeployedPackage(packageName)
+	if kerrors.IsNotFound(err) {
+		return fmt.Errorf(lang.PkgVerifyErrNotDeployed, packageName)
+	} else if err != nil {
+		return fmt.Errorf("unable to get the deployed package %s: %w", packageName, err)
+	}
+
+	if len(p.cfg.VarsOpts.SetVariables) > 0 || len(p.cfg.VarsOpts.UnsetVariables) > 0 {
+		if err := p.updateDeployedVariables(deployedPackage); err != nil {
+			return err
+		}
+		if err := p.savePackageSecret(*deployedPackage); err != nil {
+			return err
+		}
+		message.Successf("Updated the variables of %s, the next deployment of the package will default to them", packageName)
+	}
+
+	message.HeaderInfof("🔤 VARIABLES OF %s", strings.ToUpper(packageName))
+
+	names := []string{}
+	for name := range deployedPackage.Variables {
+		names = append(names, name)
+	}
+	if len(names) == 0 {
+		message.Notef("Package %q has no stored variables", packageName)
+		return nil
+	}
+	sort.Strings(names)
+
+	variableData := [][]string{}
+	for _, name := range names {
+		variable := deployedPackage.Variables[name]
+		value := variable.Value
+		switch {
+		case variable.Encrypted && p.cfg.PkgOpts.VariablesKey == "":
+			value = "**sanitized**"
+		case variable.Encrypted:
+			if value, err = variables.Decrypt(value, p.cfg.PkgOpts.VariablesKey); err != nil {
+				message.Warnf(lang.PkgDeployWarnVariableDecrypt, name, err.Error())
+				value = "**sanitized**"
+			}
+		}
+		variableData = append(variableData, []string{name, value, strconv.FormatBool(variable.Sensitive)})
+	}
+	message.Table([]string{"Variable", "Value", "Sensitive"}, variableData)
+
+	return nil
+}
+
+// updateDeployedVariables applies the --unset and then the --set variables to a deployed package.
+func (p *Packager) updateDeployedVariables(deployedPackage *types.DeployedPackage) error {
+	if deployedPackage.Variables == nil {
+		deployedPackage.Variables = map[string]types.DeployedVariable{}
+	}
+
+	for _, name := range p.cfg.VarsOpts.UnsetVariables {
+		delete(deployedPackage.Variables, name)
+	}
+
+	for name, value := range p.cfg.VarsOpts.SetVariables {
+		declared := helpers.Find(deployedPackage.Data.Variables, func(variable types.ZarfPackageVariable) bool {
+			return variable.Name == name
+		})
+		if declared.Source != "" {
+			return fmt.Errorf(lang.PkgVarsErrSourced, name)
+		}
+		if !regexp.MustCompile(declared.Pattern).MatchString(value) {
+			return fmt.Errorf("provided value for variable %q does not match pattern \"%s\"", name, declared.Pattern)
+		}
+		if err := variables.Validate(declared, value); err != nil {
+			return fmt.Errorf(lang.PkgDeployErrVariableType, name, declared.Type, err)
+		}
+
+		if !declared.Sensitive && !deployedPackage.Variables[name].Sensitive {
+			deployedPackage.Variables[name] = types.DeployedVariable{Value: value}
+			continue
+		}
+
+		if p.cfg.PkgOpts.VariablesKey == "" {
+			return fmt.Errorf(lang.PkgVarsErrSensitiveNoKey, name)
+		}
+		encrypted, err := variables.Encrypt(value, p.cfg.PkgOpts.VariablesKey)
+		if err != nil {
+			return fmt.Errorf("unable to encrypt variable %q: %w", name, err)
+		}
+		deployedPackage.Variables[name] = types.DeployedVariable{Value: encrypted, Sensitive: true, Encrypted: true}
+	}
+
+	return nil
+}
@@ -0,0 +1,487 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package packager contains functions for interacting with, managing and deploying Zarf packages.
+package packager
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/defenseunicorns/zarf/src/config"
+	"github.com/defenseunicorns/zarf/src/config/lang"
+	"github.com/defenseunicorns/zarf/src/internal/packager/git"
+	"github.com/defenseunicorns/zarf/src/internal/packager/helm"
+	"github.com/defenseunicorns/zarf/src/pkg/k8s"
+	"github.com/defenseunicorns/zarf/src/pkg/message"
+	"github.com/defenseunicorns/zarf/src/pkg/packager/sources"
+	"github.com/defenseunicorns/zarf/src/pkg/transform"
+	"github.com/defenseunicorns/zarf/src/pkg/utils"
+	"github.com/defenseunicorns/zarf/src/pkg/utils/helpers"
+	"github.com/defenseunicorns/zarf/src/types"
+	"github.com/go-git/go-git/v5/plumbing"
+	gitTransport "github.com/go-git/go-git/v5/plumbing/transport"
+	"github.com/google/go-containerregistry/pkg/crane"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+	"helm.sh/helm/v3/pkg/storage/driver"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// The statuses a verification can report for a resource, image or repo.
+const (
+	verifyStatusOK         = "ok"
+	verifyStatusDrifted    = "drifted"
+	verifyStatusMissing    = "missing"
+	verifyStatusUnverified = "unverified"
+)
+
+// verifyResult is the outcome of verifying a single resource, image or repo.
+type verifyResult struct {
+	status  string
+	details string
+}
+
+// hasDrifted returns whether the result shows that the cluster no longer matches what was deployed.
+func (r verifyResult) hasDrifted() bool {
+	return r.status == verifyStatusDrifted || r.status == verifyStatusMissing
+}
+
+// Verify checks that the resources, images and repos of a deployed package still match what was deployed.
+func (p *Packager) Verify() (err error) {
+	clusterSource, ok := p.source.(*sources.ClusterSource)
+	if !ok {
+		return fmt.Errorf("package verify requires the name of a deployed package")
+	}
+	p.cluster = clusterSource.Cluster
+
+	packageName := p.cfg.PkgOpts.PackageSource
+	deployedPackage, err := p.cluster.GetDeployedPackage(packageName)
+	if kerrors.IsNotFound(err) {
+		return fmt.Errorf(lang.PkgVerifyErrNotDeployed, packageName)
+	} else if err != nil {
+		return fmt.Errorf("unable to get the deployed package %s: %w", packageName, err)
+	}
+
+	state, err := p.cluster.LoadZarfState()
+	if err != nil && !deployedPackage.Data.Metadata.YOLO {
+		return fmt.Errorf("%s %w", lang.ErrLoadState, err)
+	}
+	p.cfg.State = state
+
+	message.HeaderInfof("🔍 VERIFYING %s", strings.ToUpper(packageName))
+
+	drifted := 0
+	resourceData := [][]string{}
+	imageData := [][]string{}
+	repoData := [][]string{}
+
+	for _, deployedComponent := range deployedPackage.DeployedComponents {
+		component := helpers.Find(deployedPackage.Data.Components, func(component types.ZarfComponent) bool {
+			return component.Name == deployedComponent.Name
+		})
+
+		spinner := message.NewProgressSpinner("Verifying component %s", deployedComponent.Name)
+
+		rows, count, err := p.verifyReleases(deployedComponent)
+		if err != nil {
+			spinner.Stop()
+			return err
+		}
+		resourceData = append(resourceData, rows...)
+		drifted += count
+
+		if len(component.Images) > 0 && state != nil {
+			rows, count, err := p.verifyImages(deployedPackage, component, deployedComponent)
+			if err != nil {
+				spinner.Stop()
+				return err
+			}
+			imageData = append(imageData, rows...)
+			drifted += count
+		}
+
+		if len(component.Repos) > 0 && state != nil {
+			rows, count, err := p.verifyRepos(component, deployedComponent)
+			if err != nil {
+				spinner.Stop()
+				return err
+			}
+			repoData = append(repoData, rows...)
+			drifted += count
+		}
+
+		spinner.Successf("Verified component %s", deployedComponent.Name)
+	}
+
+	if len(resourceData) > 0 {
+		message.Table([]string{"Component", "Release", "Kind", "Namespace", "Name", "Status", "Details"}, resourceData)
+	}
+	if len(imageData) > 0 {
+		message.Table([]string{"Component", "Image", "Status", "Details"}, imageData)
+	}
+	if len(repoData) > 0 {
+		message.Table([]string{"Component", "Repository", "Status", "Details"}, repoData)
+	}
+
+	if drifted > 0 {
+		return fmt.Errorf(lang.PkgVerifyErrDrift, drifted, packageName)
+	}
+
+	message.Successf("Package %s matches what was deployed", packageName)
+
+	return nil
+}
+
+// verifyReleases compares the resources of the Helm releases of a deployed component with the live objects in the cluster.
+func (p *Packager) verifyReleases(deployedComponent types.DeployedComponent) (rows [][]string, drifted int, err error) {
+	helmCfg := helm.NewClusterOnly(p.cfg, p.cluster)
+
+	for _, chart := range deployedComponent.InstalledCharts {
+		manifest, err := helmCfg.GetReleaseManifest(chart.Namespace, chart.ChartName)
+		if errors.Is(err, driver.ErrReleaseNotFound) {
+			rows = append(rows, []string{deployedComponent.Name, chart.ChartName, "", chart.Namespace, "", verifyStatusMissing, "the Helm release is not in the cluster"})
+			drifted++
+			continue
+		} else if err != nil {
+			return nil, 0, fmt.Errorf("unable to get the Helm release %s/%s: %w", chart.Namespace, chart.ChartName, err)
+		}
+
+		resources, err := parsePlanResources(manifest, chart.Namespace)
+		if err != nil {
+			return nil, 0, fmt.Errorf("unable to parse the resources of %s: %w", chart.ChartName, err)
+		}
+
+		keys := []string{}
+		for key := range resources {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			resource := resources[key]
+			result := p.verifyResource(resource)
+			if result.hasDrifted() {
+				drifted++
+			}
+			rows = append(rows, []string{deployedComponent.Name, chart.ChartName, resource.object.GetKind(), resource.namespace, resource.object.GetName(), result.status, result.details})
+		}
+	}
+
+	return rows, drifted, nil
+}
+
+// verifyResource compares a resource from a Helm release with its live object in the cluster.
+func (p *Packager) verifyResource(resource planResource) verifyResult {
+	live, err := p.cluster.GetResource(resource.namespace, resource.object.GetName(), resource.object.GroupVersionKind().GroupKind())
+	if kerrors.IsNotFound(err) {
+		return verifyResult{status: verifyStatusMissing}
+	} else if err != nil {
+		return verifyResult{status: verifyStatusUnverified, details: err.Error()}
+	}
+
+	if drift := diffLiveObject(comparableObject(resource.object), live.Object, "", p.isAgentMutation); len(drift) > 0 {
+		return verifyResult{status: verifyStatusDrifted, details: strings.Join(drift, ", ")}
+	}
+
+	return verifyResult{status: verifyStatusOK}
+}
+
+// comparableObject returns the fields of a rendered object that can be compared with its live object.
+func comparableObject(object *unstructured.Unstructured) map[string]any {
+	fields := map[string]any{}
+	for key, value := range object.Object {
+		fields[key] = value
+	}
+	// Status is owned by the cluster and a Secret's stringData is only ever stored as data
+	delete(fields, "status")
+	if object.GetKind() == "Secret" {
+		delete(fields, "stringData")
+	}
+	return fields
+}
+
+// isAgentMutation returns whether a live value is the deployed value as rewritten by the Zarf agent.
+func (p *Packager) isAgentMutation(deployed string, live string) bool {
+	if p.cfg.State == nil {
+		return false
+	}
+
+	if image, err := transform.ImageTransformHost(p.cfg.State.RegistryInfo.Address, deployed); err == nil && image == live {
+		return true
+	}
+
+	if repoURL, err := transform.GitURL(p.cfg.State.GitServer.Address, deployed, p.cfg.State.GitServer.PushUsername); err == nil && repoURL.String() == live {
+		return true
+	}
+
+	return false
+}
+
+// diffLiveObject returns the paths of the fields of a deployed object whose values differ in the live object.
+// Fields that only the live object has (defaults, server-managed metadata, etc) and values that isMutation accepts are not drift.
+func diffLiveObject(deployed any, live any, path string, isMutation func(deployed string, live string) bool) []string {
+	drift := []string{}
+
+	switch deployedValue := deployed.(type) {
+	case nil:
+		return drift
+	case map[string]any:
+		liveValue, ok := live.(map[string]any)
+		if !ok {
+			if live == nil && len(deployedValue) == 0 {
+				return drift
+			}
+			return append(drift, path)
+		}
+
+		keys := []string{}
+		for key := range deployedValue {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			keyPath := key
+			if path != "" {
+				keyPath = path + "." + key
+			}
+			drift = append(drift, diffLiveObject(deployedValue[key], liveValue[key], keyPath, isMutation)...)
+		}
+	case []any:
+		liveValue, ok := live.([]any)
+		if !ok {
+			if live == nil && len(deployedValue) == 0 {
+				return drift
+			}
+			return append(drift, path)
+		}
+
+		if len(liveValue) != len(deployedValue) {
+			return append(drift, path)
+		}
+
+		for idx := range deployedValue {
+			drift = append(drift, diffLiveObject(deployedValue[idx], liveValue[idx], fmt.Sprintf("%s[%d]", path, idx), isMutation)...)
+		}
+	default:
+		// The API server leaves out fields that are set to their zero value
+		if live == nil && (deployed == false || deployed == "" || fmt.Sprint(deployed) == "0") {
+			return drift
+		}
+
+		// Compare the printed values so that numbers decoded as different types are still equal
+		deployedString, liveString := fmt.Sprint(deployed), fmt.Sprint(live)
+		if deployedString != liveString && !equalQuantities(deployedString, liveString) && !isMutation(deployedString, liveString) {
+			return append(drift, path)
+		}
+	}
+
+	return drift
+}
+
+// equalQuantities returns whether two values are the same resource quantity, as the API server normalizes them (i.e. 0.5 to 500m).
+func equalQuantities(deployed, live string) bool {
+	deployedQuantity, err := resource.ParseQuantity(deployed)
+	if err != nil {
+		return false
+	}
+	liveQuantity, err := resource.ParseQuantity(live)
+	if err != nil {
+		return false
+	}
+
+	return deployedQuantity.Cmp(liveQuantity) == 0
+}
+
+// verifyImages checks that the images of a deployed component are in the Zarf registry with the digests they were deployed with.
+func (p *Packager) verifyImages(deployedPackage *types.DeployedPackage, component types.ZarfComponent, deployedComponent types.DeployedComponent) (rows [][]string, drifted int, err error) {
+	registryInfo := p.cfg.State.RegistryInfo
+
+	registryURL, tunnel, err := p.cluster.ConnectToZarfRegistryEndpoint(registryInfo)
+	if err != nil {
+		return nil, 0, err
+	}
+	if tunnel != nil {
+		defer tunnel.Close()
+	}
+
+	craneOptions := config.GetCraneOptions(config.CommonOptions.Insecure, deployedPackage.Data.Metadata.Architecture, deployedPackage.Data.Build.Architecture)
+	craneOptions = append(craneOptions, config.GetCraneAuthOption(registryInfo.PullUsername, registryInfo.PullPassword))
+
+	// The agent image is pushed without a checksum, as it is when the init package is deployed
+	transformHost := transform.ImageTransformHost
+	if deployedPackage.Data.Kind == types.ZarfInitConfig && component.Name == "zarf-agent" {
+		transformHost = transform.ImageTransformHostWithoutChecksum
+	}
+
+	for _, image := range helpers.Unique(component.Images) {
+		offlineName, err := transformHost(registryURL, image)
+		if err != nil {
+			return nil, 0, fmt.Errorf("unable to transform the image %s: %w", image, err)
+		}
+
+		var digest string
+		getDigest := func() error {
+			desc, err := crane.Head(offlineName, craneOptions...)
+			if err != nil {
+				return err
+			}
+			digest = desc.Digest.String()
+			return nil
+		}
+		if tunnel != nil {
+			err = tunnel.Wrap(getDigest)
+		} else {
+			err = getDigest()
+		}
+
+		var result verifyResult
+		var transportErr *transport.Error
+		switch {
+		case errors.As(err, &transportErr) && transportErr.StatusCode == http.StatusNotFound:
+			result = verifyResult{status: verifyStatusMissing}
+		case err != nil:
+			result = verifyResult{status: verifyStatusUnverified, details: err.Error()}
+		default:
+			result = verifyImageDigest(image, digest, deployedComponent.ImageDigests)
+		}
+
+		if result.hasDrifted() {
+			drifted++
+		}
+		rows = append(rows, []string{deployedComponent.Name, image, result.status, result.details})
+	}
+
+	return rows, drifted, nil
+}
+
+// verifyImageDigest compares the digest of an image in the registry with the digest it was deployed with.
+// Packages deployed before image digests were recorded can only be checked if the image was pinned by digest.
+func verifyImageDigest(image string, digest string, recordedDigests map[string]string) verifyResult {
+	expected := recordedDigests[image]
+	if expected == "" {
+		if refInfo, err := transform.ParseImageRef(image); err == nil {
+			expected = refInfo.Digest
+		}
+	}
+
+	switch expected {
+	case "":
+		return verifyResult{status: verifyStatusUnverified, details: "no digest was recorded for this image"}
+	case digest:
+		return verifyResult{status: verifyStatusOK}
+	default:
+		return verifyResult{status: verifyStatusDrifted, details: fmt.Sprintf("digest is %s instead of %s", digest, expected)}
+	}
+}
+
+// verifyRepos checks that the mirrored repos of a deployed component still point at the packaged refs.
+func (p *Packager) verifyRepos(component types.ZarfComponent, deployedComponent types.DeployedComponent) (rows [][]string, drifted int, err error) {
+	gitClient := git.New(p.cfg.State.GitServer)
+
+	// If this is a service, create a port-forward tunnel to it
+	svcInfo, _ := k8s.ServiceInfoFromServiceURL(gitClient.Server.Address)
+	var tunnel *k8s.Tunnel
+	if svcInfo != nil {
+		tunnel, err = p.cluster.NewTunnel(svcInfo.Namespace, k8s.SvcResource, svcInfo.Name, "", 0, svcInfo.Port)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		if _, err = tunnel.Connect(); err != nil {
+			return nil, 0, err
+		}
+		defer tunnel.Close()
+		gitClient.Server.Address = tunnel.HTTPEndpoint()
+	}
+
+	for _, repoURL := range component.Repos {
+		var mirroredRefs map[string]string
+		getRefs := func() (err error) {
+			mirroredRefs, err = gitClient.GetMirroredRefs(repoURL)
+			return err
+		}
+		if tunnel != nil {
+			err = tunnel.Wrap(getRefs)
+		} else {
+			err = getRefs()
+		}
+
+		var result verifyResult
+		switch {
+		case errors.Is(err, gitTransport.ErrRepositoryNotFound):
+			result = verifyResult{status: verifyStatusMissing}
+		case err != nil:
+			result = verifyResult{status: verifyStatusUnverified, details: err.Error()}
+		default:
+			result = verifyMirroredRef(repoURL, mirroredRefs, deployedComponent.RepoRefs[repoURL])
+		}
+
+		if result.hasDrifted() {
+			drifted++
+		}
+		rows = append(rows, []string{deployedComponent.Name, repoURL, result.status, result.details})
+	}
+
+	return rows, drifted, nil
+}
+
+// verifyMirroredRef compares the ref of a mirrored repo with the hash it had in the package.
+// Packages deployed before ref hashes were recorded can only be checked if the repo was pinned to a commit.
+func verifyMirroredRef(repoURL string, mirroredRefs map[string]string, recordedHash string) verifyResult {
+	_, refPlain, err := transform.GitURLSplitRef(repoURL)
+	if err != nil {
+		return verifyResult{status: verifyStatusUnverified, details: err.Error()}
+	}
+
+	// Repos without a ref mirror every branch and tag so there is no single ref to check
+	if refPlain == "" {
+		return verifyResult{status: verifyStatusOK}
+	}
+
+	refName := git.MirroredRefName(refPlain)
+	hash, found := mirroredRefs[refName.String()]
+	if !found {
+		return verifyResult{status: verifyStatusMissing, details: fmt.Sprintf("%s is not on the git server", refName)}
+	}
+
+	expected := recordedHash
+	if expected == "" && plumbing.IsHash(refPlain) {
+		expected = refPlain
+	}
+
+	switch expected {
+	case "":
+		return verifyResult{status: verifyStatusUnverified, details: "no hash was recorded for this ref"}
+	case hash:
+		return verifyResult{status: verifyStatusOK}
+	default:
+		return verifyResult{status: verifyStatusDrifted, details: fmt.Sprintf("%s is at %s instead of %s", refName, hash, expected)}
+	}
+}
+
+// getPackagedImageDigests returns the digests of the given images in the package, keyed by image reference.
+func (p *Packager) getPackagedImageDigests(componentImages []string) map[string]string {
+	digests := map[string]string{}
+
+	for _, image := range componentImages {
+		refInfo, err := transform.ParseImageRef(image)
+		if err != nil {
+			continue
+		}
+
+		desc, err := utils.FindOCIDescriptor(p.layout.Images.Base, refInfo)
+		if err != nil {
+			message.Debugf("Unable to find the image %s in the package: %s", image, err.Error())
+			continue
+		}
+		digests[image] = desc.Digest.String()
+	}
+
+	return digests
+}
@@ -0,0 +1,213 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package packager contains functions for interacting with, managing and deploying Zarf packages.
+package packager
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestDiffLiveObject verifies that only deployed fields that changed in the cluster are reported as drift.
+func TestDiffLiveObject(t *testing.T) {
+	t.Parallel()
+
+	deployed := map[string]any{
+		"metadata": map[string]any{
+			"name":   "podinfo",
+			"labels": map[string]any{"app": "podinfo"},
+		},
+		"spec": map[string]any{
+			"replicas":    int64(1),
+			"hostNetwork": false,
+			"template": map[string]any{
+				"spec": map[string]any{
+					"containers": []any{
+						map[string]any{"name": "podinfo", "image": "ghcr.io/stefanprodan/podinfo:6.4.0", "resources": map[string]any{}},
+					},
+				},
+			},
+		},
+	}
+
+	isMutation := func(deployed string, live string) bool {
+		return live == "127.0.0.1:31999/stefanprodan/podinfo:6.4.0-zarf-1234" && deployed == "ghcr.io/stefanprodan/podinfo:6.4.0"
+	}
+
+	type testCase struct {
+		name     string
+		live     map[string]any
+		expected []string
+	}
+
+	testCases := []testCase{
+		{
+			name: "defaults and server fields are not drift",
+			live: map[string]any{
+				"metadata": map[string]any{
+					"name":            "podinfo",
+					"labels":          map[string]any{"app": "podinfo", "zarf.dev/package": "podinfo"},
+					"resourceVersion": "1234",
+				},
+				"spec": map[string]any{
+					"replicas": float64(1),
+					"template": map[string]any{
+						"spec": map[string]any{
+							"containers": []any{
+								map[string]any{"name": "podinfo", "image": "ghcr.io/stefanprodan/podinfo:6.4.0", "imagePullPolicy": "IfNotPresent"},
+							},
+						},
+					},
+				},
+				"status": map[string]any{"replicas": int64(1)},
+			},
+			expected: []string{},
+		},
+		{
+			name: "agent mutations are not drift",
+			live: map[string]any{
+				"metadata": map[string]any{"name": "podinfo", "labels": map[string]any{"app": "podinfo"}},
+				"spec": map[string]any{
+					"replicas": int64(1),
+					"template": map[string]any{
+						"spec": map[string]any{
+							"containers": []any{
+								map[string]any{"name": "podinfo", "image": "127.0.0.1:31999/stefanprodan/podinfo:6.4.0-zarf-1234"},
+							},
+						},
+					},
+				},
+			},
+			expected: []string{},
+		},
+		{
+			name: "changed and removed fields are drift",
+			live: map[string]any{
+				"metadata": map[string]any{"name": "podinfo"},
+				"spec": map[string]any{
+					"replicas": int64(3),
+					"template": map[string]any{
+						"spec": map[string]any{
+							"containers": []any{
+								map[string]any{"name": "podinfo", "image": "ghcr.io/stefanprodan/podinfo:latest"},
+							},
+						},
+					},
+				},
+			},
+			expected: []string{"metadata.labels", "spec.replicas", "spec.template.spec.containers[0].image"},
+		},
+		{
+			name: "added list items are drift",
+			live: map[string]any{
+				"metadata": map[string]any{"name": "podinfo", "labels": map[string]any{"app": "podinfo"}},
+				"spec": map[string]any{
+					"replicas": int64(1),
+					"template": map[string]any{
+						"spec": map[string]any{
+							"containers": []any{
+								map[string]any{"name": "podinfo", "image": "ghcr.io/stefanprodan/podinfo:6.4.0"},
+								map[string]any{"name": "sidecar", "image": "busybox"},
+							},
+						},
+					},
+				},
+			},
+			expected: []string{"spec.template.spec.containers"},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tc.expected, diffLiveObject(deployed, tc.live, "", isMutation))
+		})
+	}
+}
+
+// TestDiffLiveQuantities verifies that resource quantities the API server normalizes are not drift.
+func TestDiffLiveQuantities(t *testing.T) {
+	t.Parallel()
+
+	isMutation := func(_ string, _ string) bool { return false }
+
+	type testCase struct {
+		name     string
+		deployed any
+		live     any
+		expected []string
+	}
+
+	testCases := []testCase{
+		{name: "decimal cpu", deployed: "0.5", live: "500m", expected: []string{}},
+		{name: "float cpu", deployed: float64(0.5), live: "500m", expected: []string{}},
+		{name: "byte memory", deployed: int64(1073741824), live: "1Gi", expected: []string{}},
+		{name: "changed memory", deployed: "1Gi", live: "2Gi", expected: []string{"quantity"}},
+		{name: "changed string", deployed: "IfNotPresent", live: "Always", expected: []string{"quantity"}},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tc.expected, diffLiveObject(map[string]any{"quantity": tc.deployed}, map[string]any{"quantity": tc.live}, "", isMutation))
+		})
+	}
+}
+
+// TestVerifyMirroredRef verifies that mirrored repo refs are compared against the recorded or pinned hashes.
+func TestVerifyMirroredRef(t *testing.T) {
+	t.Parallel()
+
+	commit := "c74e2e9ee8e4dc4a8aaa6d5d6b4b27d5f4f3ebd4"
+	mirroredRefs := map[string]string{
+		"refs/tags/v1.0.0":                  "1111111111111111111111111111111111111111",
+		"refs/heads/zarf-ref-" + commit:     commit,
+		"refs/heads/main":                   "2222222222222222222222222222222222222222",
+		"refs/heads/zarf-ref-v1.0.0":        "1111111111111111111111111111111111111111",
+		"refs/heads/some-other-zarf-branch": "3333333333333333333333333333333333333333",
+	}
+
+	type testCase struct {
+		name     string
+		repoURL  string
+		recorded string
+		expected string
+	}
+
+	testCases := []testCase{
+		{name: "recorded tag matches", repoURL: "https://github.com/defenseunicorns/zarf.git@v1.0.0", recorded: "1111111111111111111111111111111111111111", expected: verifyStatusOK},
+		{name: "recorded tag moved", repoURL: "https://github.com/defenseunicorns/zarf.git@v1.0.0", recorded: "4444444444444444444444444444444444444444", expected: verifyStatusDrifted},
+		{name: "unrecorded tag", repoURL: "https://github.com/defenseunicorns/zarf.git@v1.0.0", expected: verifyStatusUnverified},
+		{name: "pinned commit", repoURL: "https://github.com/defenseunicorns/zarf.git@" + commit, expected: verifyStatusOK},
+		{name: "missing ref", repoURL: "https://github.com/defenseunicorns/zarf.git@v2.0.0", expected: verifyStatusMissing},
+		{name: "whole repo", repoURL: "https://github.com/defenseunicorns/zarf.git", expected: verifyStatusOK},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tc.expected, verifyMirroredRef(tc.repoURL, mirroredRefs, tc.recorded).status)
+		})
+	}
+}
+
+// TestVerifyImageDigest verifies that image digests are compared against the recorded or pinned digests.
+func TestVerifyImageDigest(t *testing.T) {
+	t.Parallel()
+
+	digest := "sha256:b8b6f8f4b1c9e5d2d1d2f8f6e5a6c7b8a9d0e1f2a3b4c5d6e7f8a9b0c1d2e3f4"
+	recorded := map[string]string{"ghcr.io/stefanprodan/podinfo:6.4.0": digest}
+
+	require.Equal(t, verifyStatusOK, verifyImageDigest("ghcr.io/stefanprodan/podinfo:6.4.0", digest, recorded).status)
+	require.Equal(t, verifyStatusDrifted, verifyImageDigest("ghcr.io/stefanprodan/podinfo:6.4.0", "sha256:0000", recorded).status)
+	require.Equal(t, verifyStatusUnverified, verifyImageDigest("ghcr.io/stefanprodan/podinfo:6.3.0", digest, recorded).status)
+	require.Equal(t, verifyStatusOK, verifyImageDigest("ghcr.io/stefanprodan/podinfo@"+digest, digest, nil).status)
+}
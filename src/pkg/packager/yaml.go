@@ -24,6 +24,8 @@ func (p *Packager) readZarfYAML(path string) error {
 		return err
 	}
 
+	message.SetEventPackage(p.cfg.Pkg.Metadata.Name)
+
 	if p.layout.IsLegacyLayout() {
 		warning := "Detected deprecated package layout, migrating to new layout - support for this package will be dropped in v1.0.0"
 		p.warnings = append(p.warnings, warning)
@@ -7,9 +7,11 @@ package utils
 
 import (
 	"context"
+	"crypto"
 	"fmt"
 	"io"
 	"os"
+	"slices"
 	"strings"
 
 	"github.com/defenseunicorns/zarf/src/config"
@@ -28,6 +30,7 @@ import (
 	"github.com/sigstore/cosign/v2/pkg/cosign"
 	ociremote "github.com/sigstore/cosign/v2/pkg/oci/remote"
 	sigs "github.com/sigstore/cosign/v2/pkg/signature"
+	"github.com/sigstore/sigstore/pkg/signature"
 
 	// Register the provider-specific plugins
 	_ "github.com/sigstore/sigstore/pkg/signature/kms/aws"
@@ -218,6 +221,65 @@ func CosignSignBlob(blobPath string, outputSigPath string, keyPath string, passw
 	return sig, err
 }
 
+// CosignVerifyImageSignatures verifies that an image has a cosign signature made by one of the public keys provided.
+func CosignVerifyImageSignatures(ref name.Reference, keyRefs []string, opts ...remote.Option) error {
+	verifiers := map[string]signature.Verifier{}
+	for _, keyRef := range keyRefs {
+		pub, err := sigs.LoadPublicKey(context.TODO(), keyRef)
+		if err != nil {
+			return fmt.Errorf("unable to load the public key %s: %w", keyRef, err)
+		}
+		verifiers[keyRef] = pub
+	}
+
+	return cosignVerifyImageSignatures(context.TODO(), ref, verifiers, opts...)
+}
+
+// CosignVerifyImageSignaturesPEM verifies that an image has a cosign signature made by one of the PEM encoded public keys provided.
+func CosignVerifyImageSignaturesPEM(ctx context.Context, ref name.Reference, publicKeys []string, opts ...remote.Option) error {
+	verifiers := map[string]signature.Verifier{}
+	for idx, publicKey := range publicKeys {
+		pub, err := sigs.LoadPublicKeyRaw([]byte(publicKey), crypto.SHA256)
+		if err != nil {
+			return fmt.Errorf("unable to load public key #%d: %w", idx+1, err)
+		}
+		verifiers[fmt.Sprintf("public key #%d", idx+1)] = pub
+	}
+
+	return cosignVerifyImageSignatures(ctx, ref, verifiers, opts...)
+}
+
+func cosignVerifyImageSignatures(ctx context.Context, ref name.Reference, verifiers map[string]signature.Verifier, opts ...remote.Option) error {
+
+	keyNames := []string{}
+	for keyName := range verifiers {
+		keyNames = append(keyNames, keyName)
+	}
+	slices.Sort(keyNames)
+
+	failures := []string{}
+	for _, keyName := range keyNames {
+		co := &cosign.CheckOpts{
+			ClaimVerifier:      cosign.SimpleClaimVerifier,
+			RegistryClientOpts: []ociremote.Option{ociremote.WithRemoteOptions(opts...)},
+			SigVerifier:        verifiers[keyName],
+			IgnoreTlog:         true,
+			IgnoreSCT:          true,
+			Offline:            true,
+		}
+
+		if _, _, err := cosign.VerifyImageSignatures(ctx, ref, co); err != nil {
+			failures = append(failures, fmt.Sprintf("%s: %s", keyName, err.Error()))
+			continue
+		}
+
+		message.Debugf("%s cosign verified with %s", ref, keyName)
+		return nil
+	}
+
+	return fmt.Errorf("no signature of %s was verified by the public keys (%s)", ref, strings.Join(failures, ", "))
+}
+
 // GetCosignArtifacts returns signatures and attestations for the given image
 func GetCosignArtifacts(image string) (cosignList []string, err error) {
 	var cosignArtifactList []string
@@ -27,6 +27,23 @@ func Retry(fn func() error, retries int, delay time.Duration, logger func(format
 	return err
 }
 
+// RetryWithBackoff will retry a function until it succeeds or it has been called retries times, doubling the delay after each failed attempt.
+func RetryWithBackoff(fn func() error, retries int, delay time.Duration, logger func(format string, args ...any)) (err error) {
+	for r := 0; r < retries; r++ {
+		err = fn()
+		if err == nil || r == retries-1 {
+			break
+		}
+
+		logger("Retrying (%d/%d) in %s: %s", r+1, retries, delay, err.Error())
+
+		time.Sleep(delay)
+		delay *= 2
+	}
+
+	return err
+}
+
 // MergeMap merges map m2 with m1 overwriting common values with m2's values.
 func MergeMap[T any](m1, m2 map[string]T) (r map[string]T) {
 	r = map[string]T{}
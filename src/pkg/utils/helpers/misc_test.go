@@ -75,6 +75,35 @@ func (suite *TestMiscSuite) Test_0_Retry() {
 	suite.Equal(3, logCount)
 }
 
+func (suite *TestMiscSuite) Test_0_RetryWithBackoff() {
+	var count int
+	countFn := func() error {
+		count++
+		if count < 4 {
+			return errors.New("count exceeded")
+		}
+		return nil
+	}
+	var logCount int
+	loggerFn := func(_ string, _ ...any) {
+		logCount++
+	}
+
+	count = 0
+	logCount = 0
+	err := RetryWithBackoff(countFn, 3, 0, loggerFn)
+	suite.Error(err)
+	suite.Equal(3, count)
+	suite.Equal(2, logCount)
+
+	count = 0
+	logCount = 0
+	err = RetryWithBackoff(countFn, 4, 0, loggerFn)
+	suite.NoError(err)
+	suite.Equal(4, count)
+	suite.Equal(3, logCount)
+}
+
 func (suite *TestMiscSuite) Test_1_MergeMap() {
 	expected := map[string]interface{}{
 		"different": "value",
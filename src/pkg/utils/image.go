@@ -10,23 +10,27 @@ import (
 	"os"
 	"path/filepath"
 
+	"github.com/defenseunicorns/zarf/src/config"
 	"github.com/defenseunicorns/zarf/src/pkg/transform"
 	v1 "github.com/google/go-containerregistry/pkg/v1"
 	"github.com/google/go-containerregistry/pkg/v1/layout"
 	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
 )
 
-// LoadOCIImage returns a v1.Image with the image ref specified from a location provided, or an error if the image cannot be found.
-func LoadOCIImage(imgPath string, refInfo transform.Image) (v1.Image, error) {
-	// Use the manifest within the index.json to load the specific image we want
+// ImageSubjectAnnotation names the image that a signature, attestation or referrer stored in a package's OCI layout is for.
+const ImageSubjectAnnotation = "zarf.dev/image-subject"
+
+// FindOCIDescriptor returns the descriptor of the image (or multi-platform image index) with the image ref specified from a location provided.
+func FindOCIDescriptor(imgPath string, refInfo transform.Image) (v1.Descriptor, error) {
+	// Use the manifest within the index.json to find the specific image we want
 	layoutPath := layout.Path(imgPath)
 	imgIdx, err := layoutPath.ImageIndex()
 	if err != nil {
-		return nil, err
+		return v1.Descriptor{}, err
 	}
 	idxManifest, err := imgIdx.IndexManifest()
 	if err != nil {
-		return nil, err
+		return v1.Descriptor{}, err
 	}
 
 	// Search through all the manifests within this package until we find the annotation that matches our ref
@@ -35,12 +39,83 @@ func LoadOCIImage(imgPath string, refInfo transform.Image) (v1.Image, error) {
 			// A backwards compatibility shim for older Zarf versions that would leave docker.io off of image annotations
 			(manifest.Annotations[ocispec.AnnotationBaseImageName] == refInfo.Path+refInfo.TagOrDigest && refInfo.Host == "docker.io") {
 
-			// This is the image we are looking for, load it and then return
-			return layoutPath.Image(manifest.Digest)
+			// This is the image we are looking for
+			return manifest, nil
+		}
+	}
+
+	return v1.Descriptor{}, fmt.Errorf("unable to find image (%s) at the path (%s)", refInfo.Reference, imgPath)
+}
+
+// LoadOCIImage returns a v1.Image with the image ref specified from a location provided, or an error if the image cannot be found.
+// Images kept as a multi-platform image index resolve to the image for the given (or CLI) architecture.
+func LoadOCIImage(imgPath string, refInfo transform.Image, archs ...string) (v1.Image, error) {
+	desc, err := FindOCIDescriptor(imgPath, refInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	if !desc.MediaType.IsIndex() {
+		return layout.Path(imgPath).Image(desc.Digest)
+	}
+
+	imgIdx, err := LoadOCIImageIndex(imgPath, refInfo)
+	if err != nil {
+		return nil, err
+	}
+	idxManifest, err := imgIdx.IndexManifest()
+	if err != nil {
+		return nil, err
+	}
+
+	platform := v1.Platform{OS: "linux", Architecture: config.GetArch(archs...)}
+	for _, manifest := range idxManifest.Manifests {
+		if manifest.MediaType.IsImage() && manifest.Platform != nil && manifest.Platform.Satisfies(platform) {
+			return imgIdx.Image(manifest.Digest)
+		}
+	}
+
+	return nil, fmt.Errorf("unable to find the %s platform of the image (%s) at the path (%s)", platform.String(), refInfo.Reference, imgPath)
+}
+
+// LoadOCIImageIndex returns the multi-platform v1.ImageIndex with the image ref specified from a location provided, or an error if the image cannot be found or is not an index.
+func LoadOCIImageIndex(imgPath string, refInfo transform.Image) (v1.ImageIndex, error) {
+	desc, err := FindOCIDescriptor(imgPath, refInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	if !desc.MediaType.IsIndex() {
+		return nil, fmt.Errorf("the image (%s) at the path (%s) is not an image index", refInfo.Reference, imgPath)
+	}
+
+	imgIdx, err := layout.Path(imgPath).ImageIndex()
+	if err != nil {
+		return nil, err
+	}
+
+	return imgIdx.ImageIndex(desc.Digest)
+}
+
+// FindOCIArtifacts returns the descriptors of the signatures, attestations and referrers stored for the image ref specified from a location provided.
+func FindOCIArtifacts(imgPath string, refInfo transform.Image) ([]v1.Descriptor, error) {
+	imgIdx, err := layout.Path(imgPath).ImageIndex()
+	if err != nil {
+		return nil, err
+	}
+	idxManifest, err := imgIdx.IndexManifest()
+	if err != nil {
+		return nil, err
+	}
+
+	artifacts := []v1.Descriptor{}
+	for _, manifest := range idxManifest.Manifests {
+		if manifest.Annotations[ImageSubjectAnnotation] == refInfo.Reference {
+			artifacts = append(artifacts, manifest)
 		}
 	}
 
-	return nil, fmt.Errorf("unable to find image (%s) at the path (%s)", refInfo.Reference, imgPath)
+	return artifacts, nil
 }
 
 // AddImageNameAnnotation adds an annotation to the index.json file so that the deploying code can figure out what the image reference <-> digest shasum will be.
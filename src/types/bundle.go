@@ -0,0 +1,32 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2021-Present The Zarf Authors
+
+// Package types contains all the types used by Zarf.
+package types
+
+// ZarfBundleConfig is the kind of a Zarf bundle file, used during `zarf bundle deploy`.
+const ZarfBundleConfig = "ZarfBundleConfig"
+
+// ZarfBundle is a declarative configuration for deploying several Zarf packages together.
+type ZarfBundle struct {
+	Kind     string              `json:"kind" jsonschema:"description=The kind of Zarf bundle,enum=ZarfBundleConfig"`
+	Metadata ZarfBundleMetadata  `json:"metadata,omitempty" jsonschema:"description=Bundle metadata"`
+	Packages []ZarfBundlePackage `json:"packages" jsonschema:"description=List of packages to deploy in this bundle, in the order they are deployed unless dependsOn says otherwise"`
+}
+
+// ZarfBundleMetadata lists information about the current ZarfBundle.
+type ZarfBundleMetadata struct {
+	Name        string `json:"name,omitempty" jsonschema:"description=Name to identify this Zarf bundle"`
+	Description string `json:"description,omitempty" jsonschema:"description=Additional information about this bundle"`
+}
+
+// ZarfBundlePackage is a package to deploy as part of a ZarfBundle.
+type ZarfBundlePackage struct {
+	Name       string            `json:"name" jsonschema:"description=The name used to refer to this package within the bundle"`
+	Source     string            `json:"source" jsonschema:"description=The package source (a tarball path relative to the bundle file, an oci:// reference or an http(s):// URL)"`
+	Shasum     string            `json:"shasum,omitempty" jsonschema:"description=The SHA256 checksum of the package, required for remote packages unless --insecure is set"`
+	PublicKey  string            `json:"publicKey,omitempty" jsonschema:"description=Path to the public key used to validate a signed package, relative to the bundle file"`
+	Components []string          `json:"components,omitempty" jsonschema:"description=The optional components to deploy, with the same globbing and deselection support as --components"`
+	Variables  map[string]string `json:"variables,omitempty" jsonschema:"description=Deployment variables to set for this package (KEY=value)"`
+	DependsOn  []string          `json:"dependsOn,omitempty" jsonschema:"description=The names of the bundle packages that must be deployed before this one"`
+}
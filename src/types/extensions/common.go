@@ -4,7 +4,8 @@
 // Package extensions contains the types for all official extensions.
 package extensions
 
-// ZarfComponentExtensions is a struct that contains all the official extensions
+// ZarfComponentExtensions is a struct that contains all the official extensions.
+// Each extension's config is a typed field keyed by the name the extension is registered under in src/extensions.
 type ZarfComponentExtensions struct {
 	// Big Bang Configurations
 	BigBang *BigBang `json:"bigbang,omitempty" jsonschema:"description=Configurations for installing Big Bang and Flux in the cluster"`
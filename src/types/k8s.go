@@ -44,6 +44,8 @@ type ZarfState struct {
 	RegistryInfo   RegistryInfo       `json:"registryInfo" jsonschema:"description=Information about the container registry Zarf is configured to use"`
 	ArtifactServer ArtifactServerInfo `json:"artifactServer" jsonschema:"description=Information about the artifact registry Zarf is configured to use"`
 	LoggingSecret  string             `json:"loggingSecret" jsonschema:"description=Secret value that the internal Grafana server was seeded with"`
+
+	ImageVerification ImageVerificationInfo `json:"imageVerification,omitempty" jsonschema:"description=Information about the image signatures the Zarf agent enforces"`
 }
 
 // DeployedPackage contains information about a Zarf Package that has been deployed to a cluster
@@ -56,14 +58,26 @@ type DeployedPackage struct {
 	DeployedComponents []DeployedComponent           `json:"deployedComponents"`
 	ComponentWebhooks  map[string]map[string]Webhook `json:"componentWebhooks,omitempty"`
 	ConnectStrings     ConnectStrings                `json:"connectStrings,omitempty"`
+	Variables          map[string]DeployedVariable   `json:"variables,omitempty"`
+}
+
+// DeployedVariable contains the value a package variable was deployed with.
+type DeployedVariable struct {
+	Value     string `json:"value"`
+	Sensitive bool   `json:"sensitive,omitempty"`
+	// Encrypted is set when the (sensitive) value is encrypted with the key given by --variables-key
+	Encrypted bool `json:"encrypted,omitempty"`
 }
 
 // DeployedComponent contains information about a Zarf Package Component that has been deployed to a cluster.
 type DeployedComponent struct {
-	Name               string           `json:"name"`
-	InstalledCharts    []InstalledChart `json:"installedCharts"`
-	Status             ComponentStatus  `json:"status"`
-	ObservedGeneration int              `json:"observedGeneration"`
+	Name               string            `json:"name"`
+	InstalledCharts    []InstalledChart  `json:"installedCharts"`
+	MirroredRepos      []string          `json:"mirroredRepos,omitempty"`
+	ImageDigests       map[string]string `json:"imageDigests,omitempty"`
+	RepoRefs           map[string]string `json:"repoRefs,omitempty"`
+	Status             ComponentStatus   `json:"status"`
+	ObservedGeneration int               `json:"observedGeneration"`
 }
 
 // Webhook contains information about a Component Webhook operating on a Zarf package secret.
@@ -100,6 +114,11 @@ type ArtifactServerInfo struct {
 	InternalServer bool   `json:"internalServer" jsonschema:"description=Indicates if we are using a artifact registry that Zarf is directly managing"`
 }
 
+// ImageVerificationInfo contains the public keys the Zarf agent verifies image signatures with.
+type ImageVerificationInfo struct {
+	PublicKeys []string `json:"publicKeys,omitempty" jsonschema:"description=PEM encoded cosign public keys that every image in the registry must be signed by one of before the Zarf agent admits its pods"`
+}
+
 // RegistryInfo contains information Zarf uses to communicate with a container registry to push/pull images.
 type RegistryInfo struct {
 	PushUsername string `json:"pushUsername" jsonschema:"description=Username of a user with push access to the registry"`
@@ -65,7 +65,12 @@ type ZarfPackageVariable struct {
 	Sensitive   bool         `json:"sensitive,omitempty" jsonschema:"description=Whether to mark this variable as sensitive to not print it in the Zarf log"`
 	AutoIndent  bool         `json:"autoIndent,omitempty" jsonschema:"description=Whether to automatically indent the variable's value (if multiline) when templating. Based on the number of chars before the start of ###ZARF_VAR_."`
 	Pattern     string       `json:"pattern,omitempty" jsonschema:"description=An optional regex pattern that a variable value must match before a package can be deployed."`
-	Type        VariableType `json:"type,omitempty" jsonschema:"description=Changes the handling of a variable to load contents differently (i.e. from a file rather than as a raw variable - templated files should be kept below 1 MiB),enum=raw,enum=file"`
+	Type        VariableType `json:"type,omitempty" jsonschema:"description=Changes the handling of a variable to load contents differently (i.e. from a file rather than as a raw variable - templated files should be kept below 1 MiB) or to validate its value as a type before a package can be deployed,enum=raw,enum=file,enum=int,enum=bool,enum=enum,enum=cidr,enum=hostname,enum=url,enum=duration,enum=yaml,enum=json"`
+	Min         *int         `json:"min,omitempty" jsonschema:"description=The minimum value of an int variable"`
+	Max         *int         `json:"max,omitempty" jsonschema:"description=The maximum value of an int variable"`
+	Choices     []string     `json:"choices,omitempty" jsonschema:"description=The values an enum variable can be set to (prompts for the variable select from these)"`
+	Schema      string       `json:"schema,omitempty" jsonschema:"description=An inline JSON schema (as JSON or YAML) that the value of a yaml or json variable must match"`
+	Source      string       `json:"source,omitempty" jsonschema:"description=An external source to resolve the value of the variable from at deploy time in place of the default or a prompt (env://NAME, file://path, k8sSecret://namespace/name#key or vault://host/path#key),example=k8sSecret://my-namespace/my-secret#password"`
 }
 
 // ZarfPackageConstant are constants that can be used to dynamically template K8s resources.
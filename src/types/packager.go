@@ -15,6 +15,12 @@ type PackagerConfig struct {
 	// DeployOpts tracks user-defined values for the active deployment
 	DeployOpts ZarfDeployOptions
 
+	// VarsOpts tracks user-defined changes to the variables of a deployed package
+	VarsOpts ZarfVarsOptions
+
+	// RemoveOpts tracks user-defined values for the active remove
+	RemoveOpts ZarfRemoveOptions
+
 	// MirrorOpts tracks user-defined values for the active mirror
 	MirrorOpts ZarfMirrorOptions
 
@@ -13,8 +13,29 @@ const (
 	RawVariableType VariableType = "raw"
 	// FileVariableType is a type for a Zarf package variable that loads its contents from a file
 	FileVariableType VariableType = "file"
+	// IntVariableType is a type for a Zarf package variable that must be an integer (optionally between a min and max)
+	IntVariableType VariableType = "int"
+	// BoolVariableType is a type for a Zarf package variable that must be a boolean
+	BoolVariableType VariableType = "bool"
+	// EnumVariableType is a type for a Zarf package variable that must be one of its choices
+	EnumVariableType VariableType = "enum"
+	// CIDRVariableType is a type for a Zarf package variable that must be a CIDR (i.e. 10.0.0.0/16)
+	CIDRVariableType VariableType = "cidr"
+	// HostnameVariableType is a type for a Zarf package variable that must be an RFC 1123 hostname
+	HostnameVariableType VariableType = "hostname"
+	// URLVariableType is a type for a Zarf package variable that must be an absolute URL
+	URLVariableType VariableType = "url"
+	// DurationVariableType is a type for a Zarf package variable that must be a Go duration (i.e. 1h30m)
+	DurationVariableType VariableType = "duration"
+	// YAMLVariableType is a type for a Zarf package variable that must be YAML (optionally matching a JSON schema)
+	YAMLVariableType VariableType = "yaml"
+	// JSONVariableType is a type for a Zarf package variable that must be JSON (optionally matching a JSON schema)
+	JSONVariableType VariableType = "json"
 )
 
+// GoTemplate is the template of manifests and files that are rendered as Go templates on deploy.
+const GoTemplate = "gotemplate"
+
 // Zarf looks for these strings in zarf.yaml to make dynamic changes
 const (
 	ZarfPackageTemplatePrefix = "###ZARF_PKG_TMPL_"
@@ -43,12 +64,14 @@ type ZarfPackageOptions struct {
 	SGetKeyPath        string            `json:"sGetKeyPath" jsonschema:"description=Location where the public key component of a cosign key-pair can be found"`
 	SetVariables       map[string]string `json:"setVariables" jsonschema:"description=Key-Value map of variable names and their corresponding values that will be used to template manifests and files in the Zarf package"`
 	PublicKeyPath      string            `json:"publicKeyPath" jsonschema:"description=Location where the public key component of a cosign key-pair can be found"`
+	VariablesKey       string            `json:"variablesKey" jsonschema:"description=Key to encrypt and decrypt the sensitive variables stored with a deployed package"`
 }
 
 // ZarfInspectOptions tracks the user-defined preferences during a package inspection.
 type ZarfInspectOptions struct {
 	ViewSBOM      bool   `json:"sbom" jsonschema:"description=View SBOM contents while inspecting the package"`
 	SBOMOutputDir string `json:"sbomOutput" jsonschema:"description=Location to output an SBOM into after package inspection"`
+	ViewVulns     bool   `json:"vulns" jsonschema:"description=View the vulnerability scan findings of the package while inspecting it"`
 }
 
 // ZarfFindImagesOptions tracks the user-defined preferences during a prepare find-images search.
@@ -62,9 +85,23 @@ type ZarfDeployOptions struct {
 	AdoptExistingResources bool          `json:"adoptExistingResources" jsonschema:"description=Whether to adopt any pre-existing K8s resources into the Helm charts managed by Zarf"`
 	SkipWebhooks           bool          `json:"componentWebhooks" jsonschema:"description=Skip waiting for external webhooks to execute as each package component is deployed"`
 	Timeout                time.Duration `json:"timeout" jsonschema:"description=Timeout for performing Helm operations"`
+	Atomic                 bool          `json:"atomic" jsonschema:"description=Whether to roll back all Helm releases and the package secret touched by this deployment if any component fails"`
+	Plan                   bool          `json:"plan" jsonschema:"description=Whether to only print the changes this deployment would make to the cluster instead of deploying"`
+	Concurrency            int           `json:"concurrency" jsonschema:"description=Number of components to deploy at the same time when their dependencies allow it"`
+	ImagePushConcurrency   int           `json:"imagePushConcurrency" jsonschema:"description=Number of images to push to the Zarf registry at the same time"`
+	Resume                 bool          `json:"resume" jsonschema:"description=Whether to skip the components an interrupted deployment of the same package already deployed"`
+	ImageVerifyKeys        []string      `json:"imageVerifyKeys" jsonschema:"description=Public keys that every image must have a cosign signature from once it is pushed to the Zarf registry"`
+}
 
-	// TODO (@WSTARR): This is a library only addition to Zarf and should be refactored in the future (potentially to utilize component composability). As is it should NOT be exposed directly on the CLI
-	ValuesOverridesMap map[string]map[string]map[string]interface{} `json:"valuesOverridesMap" jsonschema:"description=[Library Only] A map of component names to chart names containing Helm Chart values to override values on deploy"`
+// ZarfVarsOptions tracks the user-defined changes to the variables of a deployed package.
+type ZarfVarsOptions struct {
+	SetVariables   map[string]string `json:"setVariables" jsonschema:"description=Key-Value map of variable names and the values to store for the deployed package"`
+	UnsetVariables []string          `json:"unsetVariables" jsonschema:"description=Names of the variables to remove from the deployed package"`
+}
+
+// ZarfRemoveOptions tracks the user-defined preferences during a package remove.
+type ZarfRemoveOptions struct {
+	PruneRepos bool `json:"pruneRepos" jsonschema:"description=Whether to delete the repos of removed components from the Zarf git server once no other package references them"`
 }
 
 // ZarfMirrorOptions tracks the user-defined preferences during a package mirror.
@@ -95,6 +132,8 @@ type ZarfInitOptions struct {
 	ArtifactServer ArtifactServerInfo `json:"artifactServer" jsonschema:"description=Information about the artifact registry Zarf is going to be using"`
 
 	StorageClass string `json:"storageClass" jsonschema:"description=StorageClass of the k8s cluster Zarf is initializing"`
+
+	ImageVerifyKeys []string `json:"imageVerifyKeys" jsonschema:"description=Paths to the cosign public keys the Zarf agent verifies image signatures with"`
 }
 
 // ZarfCreateOptions tracks the user-defined options used to create the package.
@@ -111,6 +150,9 @@ type ZarfCreateOptions struct {
 	DifferentialData   DifferentialData  `json:"differential" jsonschema:"description=A package's differential images and git repositories from a referenced previously built package"`
 	RegistryOverrides  map[string]string `json:"registryOverrides" jsonschema:"description=A map of domains to override on package create when pulling images"`
 	Flavor             string            `json:"flavor" jsonschema:"description=An optional variant that controls which components will be included in a package"`
+	ImagePlatforms     []string          `json:"imagePlatforms" jsonschema:"description=The platforms of multi-platform images to keep in the package as an image index (or 'all' to keep every platform)"`
+	VulnDBPath         string            `json:"vulnDBPath" jsonschema:"description=Location of an offline Grype vulnerability database archive to scan the package SBOMs against"`
+	FailOnSeverity     string            `json:"failOnSeverity" jsonschema:"description=Fail package creation if the vulnerability scan finds anything at or above this severity"`
 	IsSkeleton         bool              `json:"isSkeleton" jsonschema:"description=Whether to create a skeleton package"`
 	NoYOLO             bool              `json:"noYOLO" jsonschema:"description=Whether to create a YOLO package"`
 }